@@ -0,0 +1,18 @@
+// Command unitcheck reports definitely incompatible units of quantities and money, and ignored errors
+// of their operations, with the analyzer of the analysis/unitcheck package.
+//
+// Run it directly on packages, or through go vet:
+//
+//	go install github.com/IAmRadek/metric/analysis/cmd/unitcheck@latest
+//	unitcheck ./...
+//	go vet -vettool=$(which unitcheck) ./...
+package main
+
+import (
+	"github.com/IAmRadek/metric/analysis/unitcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(unitcheck.Analyzer)
+}
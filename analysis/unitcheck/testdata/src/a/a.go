@@ -0,0 +1,114 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/money"
+	"units"
+)
+
+func incompatible() error {
+	distance := metric.NewQuantity(5, metric.Meter)
+	duration := metric.NewQuantity(2, metric.Second)
+
+	if _, err := distance.Add(duration); err != nil { // want `Add of metric.Second to metric.Meter always fails with metric.ErrIncompatibleMetric`
+		return err
+	}
+	if _, err := distance.Subtract(metric.NewMeasuredQuantity(1, 0.1, metric.Kelvin)); err != nil { // want `Subtract of metric.Kelvin to metric.Meter`
+		return err
+	}
+	if _, err := distance.Equals(metric.NewQuantity(1, units.Sec)); err != nil { // want `Equals of metric.Second to metric.Meter`
+		return err
+	}
+
+	unit := metric.Second
+	doubled, err := metric.NewQuantity(1, unit).Multiply(2)
+	if err != nil {
+		return err
+	}
+	if _, err := distance.LessThan(doubled); err != nil { // want `LessThan of metric.Second to metric.Meter`
+		return err
+	}
+
+	converted, err := metric.UnitConverter.Convert(distance, metric.Kelvin)
+	if err != nil {
+		return err
+	}
+	if _, err := converted.Add(distance); err != nil { // want `Add of metric.Meter to metric.Kelvin`
+		return err
+	}
+
+	dollars := money.NewMoney(100, money.USD)
+	if _, err := dollars.Add(money.NewMoney(100, money.EUR)); err != nil { // want `Add of money.EUR to money.USD`
+		return err
+	}
+
+	return nil
+}
+
+func compatible(parameter metric.Quantity, candidates []metric.Unit) error {
+	distance := metric.NewQuantity(5, metric.Meter)
+
+	// The same unit, also through an alias in another package.
+	if _, err := distance.Add(metric.NewQuantity(1, units.Metre)); err != nil {
+		return err
+	}
+
+	// Parameters are unknown.
+	if _, err := distance.Add(parameter); err != nil {
+		return err
+	}
+
+	// Variables assigned different units are unknown.
+	quantity := metric.NewQuantity(1, metric.Meter)
+	if parameter == nil {
+		quantity = metric.NewQuantity(1, metric.Second)
+	}
+	if _, err := distance.Add(quantity); err != nil {
+		return err
+	}
+
+	// Variables assigned through a pointer are unknown.
+	pointed := metric.NewQuantity(1, metric.Second)
+	reassign(&pointed)
+	if _, err := distance.Add(pointed); err != nil {
+		return err
+	}
+
+	// Variables assigned by a range loop are unknown.
+	var unit metric.Unit = metric.Second
+	for _, unit = range candidates {
+	}
+	if _, err := distance.Add(metric.NewQuantity(1, unit)); err != nil {
+		return err
+	}
+
+	// Products change the unit.
+	area, err := distance.MultiplyBy(distance)
+	if err != nil {
+		return err
+	}
+	if _, err := distance.Add(area); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func reassign(q *metric.Quantity) {
+	*q = metric.NewQuantity(1, metric.Meter)
+}
+
+func ignored() {
+	distance := metric.NewQuantity(5, metric.Meter)
+
+	distance.Add(distance)                          // want `error returned by Quantity.Add is ignored`
+	sum, _ := distance.Add(distance)                // want `error returned by Quantity.Add is ignored`
+	_, _ = metric.ParseQuantity("5 m")              // want `error returned by metric.ParseQuantity is ignored`
+	metric.UnitConverter.Convert(sum, metric.Meter) // want `error returned by metric.UnitConverter.Convert is ignored`
+	money.NewMoney(100, money.USD).Split(3)         // want `error returned by Money.Split is ignored`
+
+	_ = money.NewMoney(100, money.USD).IsZero()
+	fmt.Println(distance.Amount())
+}
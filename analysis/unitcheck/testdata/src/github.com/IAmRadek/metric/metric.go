@@ -0,0 +1,50 @@
+// Package metric is a stub of github.com/IAmRadek/metric for the tests of unitcheck.
+package metric
+
+type Metric interface {
+	Symbol() string
+}
+
+type Unit interface {
+	Metric
+}
+
+type unit string
+
+func (u unit) Symbol() string { return string(u) }
+
+var (
+	Meter  Unit = unit("m")
+	Second Unit = unit("s")
+	Kelvin Unit = unit("K")
+)
+
+type Quantity interface {
+	Amount() float64
+	Metric() Metric
+	Add(Quantity) (Quantity, error)
+	Subtract(Quantity) (Quantity, error)
+	Multiply(multiplier float64) (Quantity, error)
+	MultiplyBy(multiplier Quantity) (Quantity, error)
+	Equals(Quantity) (bool, error)
+	LessThan(Quantity) (bool, error)
+}
+
+func NewQuantity(amount float64, metric Metric) Quantity { return nil }
+
+type MeasuredQuantity interface {
+	Quantity
+	Uncertainty() float64
+}
+
+func NewMeasuredQuantity(amount, uncertainty float64, metric Metric) MeasuredQuantity { return nil }
+
+func ParseQuantity(s string) (Quantity, error) { return nil, nil }
+
+type defaultUnitConverter struct{}
+
+func (c *defaultUnitConverter) Convert(quantity Quantity, target Unit) (Quantity, error) {
+	return nil, nil
+}
+
+var UnitConverter = &defaultUnitConverter{}
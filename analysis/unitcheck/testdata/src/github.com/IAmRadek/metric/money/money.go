@@ -0,0 +1,28 @@
+// Package money is a stub of github.com/IAmRadek/metric/money for the tests of unitcheck.
+package money
+
+type Currency interface {
+	Code() string
+}
+
+type currency string
+
+func (c currency) Code() string { return string(c) }
+
+var (
+	USD Currency = currency("USD")
+	EUR Currency = currency("EUR")
+)
+
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+func NewMoney(minorUnit int64, currency Currency) Money { return Money{minorUnit, currency} }
+
+func (m Money) Add(m2 Money) (Money, error)      { return m, nil }
+func (m Money) Subtract(m2 Money) (Money, error) { return m, nil }
+func (m Money) Equals(m2 Money) (bool, error)    { return false, nil }
+func (m Money) Split(parts int) ([]Money, error) { return nil, nil }
+func (m Money) IsZero() bool                     { return m.amount == 0 }
@@ -0,0 +1,11 @@
+// Package units declares aliases of units in another package.
+package units
+
+import "github.com/IAmRadek/metric"
+
+var (
+	Metre = metric.Meter // want Metre:"alias of github.com/IAmRadek/metric.Meter"
+	Sec   = Metre2       // want Sec:"alias of github.com/IAmRadek/metric.Second"
+
+	Metre2 = metric.Second // want Metre2:"alias of github.com/IAmRadek/metric.Second"
+)
@@ -0,0 +1,418 @@
+// Package unitcheck defines an Analyzer that reports unit mismatches of quantities and money at build time.
+//
+// The analyzer tracks the Metric passed to metric.NewQuantity, metric.NewMeasuredQuantity, money.NewMoney and
+// money.NewMoneyFromDecimal through the local variables of a function, and reports calls of Add, Subtract, Equals,
+// GreaterThan and LessThan that always fail with metric.ErrIncompatibleMetric, e.g.
+//
+//	distance := metric.NewQuantity(5, metric.Meter)
+//	duration := metric.NewQuantity(2, metric.Second)
+//	total, err := distance.Add(duration) // Add of metric.Second to metric.Meter always fails with metric.ErrIncompatibleMetric
+//
+// Metrics are known only if they are package-level variables, such as metric.Meter or money.USD, possibly assigned
+// to local variables first. Variables assigned more than once are known only if every assignment gives the same metric,
+// and parameters, fields and variables whose address is taken are never known, so that every report is definite.
+//
+// The analyzer also reports ignored errors of the functions and methods of the metric and money packages,
+// whether the call is a statement of its own or its error is assigned to the blank identifier.
+package unitcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	metricPath = "github.com/IAmRadek/metric"
+	moneyPath  = "github.com/IAmRadek/metric/money"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name:      "unitcheck",
+	Doc:       "report definitely incompatible units of quantities and money, and ignored errors of their operations",
+	URL:       "https://pkg.go.dev/github.com/IAmRadek/metric/analysis/unitcheck",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(aliasFact)},
+}
+
+// aliasFact marks a package-level variable initialized with another one, e.g. "var Metre = metric.Meter",
+// so that both are recognized as the same unit in other packages.
+type aliasFact struct {
+	// Unit is the unit the variable refers to, as "<package path>.<name>".
+	Unit string
+}
+
+func (*aliasFact) AFact() {}
+
+func (f *aliasFact) String() string {
+	return "alias of " + f.Unit
+}
+
+// assignment is a value assigned to a local variable: the index-th result of the expression.
+type assignment struct {
+	value ast.Expr
+	index int
+}
+
+type checker struct {
+	pass *analysis.Pass
+
+	// assignments lists every value assigned to local variables; unknown marks the variables that may also be
+	// assigned in other ways, e.g. through a pointer or by a range loop.
+	assignments map[*types.Var][]assignment
+	unknown     map[*types.Var]bool
+
+	// aliases maps the package-level variables of the package initialized with another variable to that variable.
+	aliases map[*types.Var]*types.Var
+
+	resolving map[*types.Var]bool
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	c := &checker{
+		pass:        pass,
+		assignments: make(map[*types.Var][]assignment),
+		unknown:     make(map[*types.Var]bool),
+		aliases:     make(map[*types.Var]*types.Var),
+		resolving:   make(map[*types.Var]bool),
+	}
+
+	c.collectAliases()
+	c.collectAssignments(inspect)
+
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil), (*ast.ExprStmt)(nil), (*ast.AssignStmt)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			c.checkCompatibility(n)
+		case *ast.ExprStmt:
+			if call, ok := ast.Unparen(n.X).(*ast.CallExpr); ok {
+				c.checkIgnoredError(call, nil)
+			}
+		case *ast.AssignStmt:
+			if len(n.Rhs) == 1 {
+				if call, ok := ast.Unparen(n.Rhs[0]).(*ast.CallExpr); ok {
+					c.checkIgnoredError(call, n.Lhs)
+				}
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// collectAliases finds the package-level variables initialized with another variable and exports their aliasFacts.
+func (c *checker) collectAliases() {
+	for _, file := range c.pass.Files {
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				if len(spec.Names) != len(spec.Values) {
+					continue
+				}
+				for i, name := range spec.Names {
+					v, ok := c.pass.TypesInfo.Defs[name].(*types.Var)
+					if !ok {
+						continue
+					}
+					if target := c.packageVar(spec.Values[i]); target != nil && target != v {
+						c.aliases[v] = target
+					}
+				}
+			}
+		}
+	}
+
+	for v := range c.aliases {
+		c.pass.ExportObjectFact(v, &aliasFact{Unit: c.unitName(v)})
+	}
+}
+
+// collectAssignments records the values assigned to local variables.
+func (c *checker) collectAssignments(inspect *inspector.Inspector) {
+	record := func(lhs []ast.Expr, rhs []ast.Expr) {
+		for i, expr := range lhs {
+			v := c.localVar(expr)
+			if v == nil {
+				continue
+			}
+			switch {
+			case len(lhs) == len(rhs):
+				c.assignments[v] = append(c.assignments[v], assignment{rhs[i], 0})
+			case len(rhs) == 1:
+				c.assignments[v] = append(c.assignments[v], assignment{rhs[0], i})
+			default:
+				c.unknown[v] = true
+			}
+		}
+	}
+
+	nodes := []ast.Node{(*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil), (*ast.RangeStmt)(nil), (*ast.UnaryExpr)(nil)}
+	inspect.Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.ASSIGN || n.Tok == token.DEFINE {
+				record(n.Lhs, n.Rhs)
+				return
+			}
+			for _, expr := range n.Lhs {
+				c.markUnknown(expr)
+			}
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			if len(n.Values) > 0 {
+				record(lhs, n.Values)
+			}
+		case *ast.RangeStmt:
+			c.markUnknown(n.Key)
+			c.markUnknown(n.Value)
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				c.markUnknown(n.X)
+			}
+		}
+	})
+}
+
+func (c *checker) markUnknown(expr ast.Expr) {
+	if v := c.localVar(expr); v != nil {
+		c.unknown[v] = true
+	}
+}
+
+// localVar returns the local variable named by the expression, or nil.
+func (c *checker) localVar(expr ast.Expr) *types.Var {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	v, ok := c.pass.TypesInfo.ObjectOf(ident).(*types.Var)
+	if !ok || v.IsField() || v.Pkg() == nil || v.Parent() == v.Pkg().Scope() {
+		return nil
+	}
+	return v
+}
+
+// packageVar returns the package-level variable referred to by the expression, e.g. metric.Meter, or nil.
+func (c *checker) packageVar(expr ast.Expr) *types.Var {
+	var ident *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return nil
+	}
+
+	v, ok := c.pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || v.IsField() || v.Pkg() == nil || v.Parent() != v.Pkg().Scope() {
+		return nil
+	}
+	return v
+}
+
+// unitName returns the name of the unit of the package-level variable, following aliases.
+func (c *checker) unitName(v *types.Var) string {
+	seen := make(map[*types.Var]bool)
+	for c.aliases[v] != nil && !seen[v] {
+		seen[v] = true
+		v = c.aliases[v]
+	}
+
+	var fact aliasFact
+	if c.pass.ImportObjectFact(v, &fact) {
+		return fact.Unit
+	}
+	return v.Pkg().Path() + "." + v.Name()
+}
+
+// metricOf returns the unit of the expression used as a Metric or Currency.
+func (c *checker) metricOf(expr ast.Expr) (string, bool) {
+	if v := c.packageVar(expr); v != nil {
+		return c.unitName(v), true
+	}
+	if v := c.localVar(expr); v != nil {
+		return c.resolve(v, func(a assignment) (string, bool) {
+			if a.index != 0 {
+				return "", false
+			}
+			return c.metricOf(a.value)
+		})
+	}
+	return "", false
+}
+
+// quantityOf returns the unit of the index-th result of the expression giving a Quantity or Money.
+func (c *checker) quantityOf(expr ast.Expr, index int) (string, bool) {
+	expr = ast.Unparen(expr)
+
+	if v := c.localVar(expr); v != nil {
+		if index != 0 {
+			return "", false
+		}
+		return c.resolve(v, func(a assignment) (string, bool) {
+			return c.quantityOf(a.value, a.index)
+		})
+	}
+
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || index != 0 {
+		return "", false
+	}
+
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return "", false
+	}
+
+	switch recv := fn.Type().(*types.Signature).Recv(); {
+	case recv == nil && fn.Pkg().Path() == metricPath && fn.Name() == "NewQuantity" && len(call.Args) == 2:
+		return c.metricOf(call.Args[1])
+	case recv == nil && fn.Pkg().Path() == metricPath && fn.Name() == "NewMeasuredQuantity" && len(call.Args) == 3:
+		return c.metricOf(call.Args[2])
+	case recv == nil && fn.Pkg().Path() == moneyPath && (fn.Name() == "NewMoney" || fn.Name() == "NewMoneyFromDecimal") && len(call.Args) == 2:
+		return c.metricOf(call.Args[1])
+	case recv != nil && fn.Pkg().Path() == metricPath && fn.Name() == "Convert" && len(call.Args) == 2:
+		return c.metricOf(call.Args[1])
+	case recv != nil && isPackage(fn, metricPath, moneyPath) && keepsUnit[fn.Name()]:
+		if selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr); ok {
+			return c.quantityOf(selector.X, 0)
+		}
+	}
+
+	return "", false
+}
+
+// keepsUnit lists the methods of Quantity and Money returning a value in the unit of the receiver.
+var keepsUnit = map[string]bool{
+	"Add": true, "Subtract": true, "Multiply": true, "Divide": true, "Round": true, "AfterTax": true, "AfterTaxes": true,
+}
+
+// resolve returns the unit of the local variable if every value assigned to it has the same known unit.
+func (c *checker) resolve(v *types.Var, unitOf func(assignment) (string, bool)) (string, bool) {
+	assignments := c.assignments[v]
+	if c.unknown[v] || len(assignments) == 0 || c.resolving[v] {
+		return "", false
+	}
+
+	c.resolving[v] = true
+	defer delete(c.resolving, v)
+
+	var unit string
+	for i, a := range assignments {
+		u, ok := unitOf(a)
+		if !ok || i > 0 && u != unit {
+			return "", false
+		}
+		unit = u
+	}
+
+	return unit, true
+}
+
+// requiresSameUnit lists the methods of Quantity and Money that fail for arguments in other units.
+var requiresSameUnit = map[string]bool{
+	"Add": true, "Subtract": true, "Equals": true, "GreaterThan": true, "LessThan": true,
+}
+
+// checkCompatibility reports calls of methods requiring the same unit with arguments in definitely different units.
+func (c *checker) checkCompatibility(call *ast.CallExpr) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || !requiresSameUnit[fn.Name()] || fn.Type().(*types.Signature).Recv() == nil || !isPackage(fn, metricPath, moneyPath) {
+		return
+	}
+
+	selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || len(call.Args) != 1 {
+		return
+	}
+
+	receiver, ok := c.quantityOf(selector.X, 0)
+	if !ok {
+		return
+	}
+	argument, ok := c.quantityOf(call.Args[0], 0)
+	if !ok || argument == receiver {
+		return
+	}
+
+	c.pass.ReportRangef(call, "%s of %s to %s always fails with metric.ErrIncompatibleMetric",
+		fn.Name(), shortName(argument), shortName(receiver))
+}
+
+// checkIgnoredError reports calls of the functions of the metric and money packages whose error is ignored:
+// the results are not assigned at all if lhs is nil, or the error is assigned to the blank identifier.
+func (c *checker) checkIgnoredError(call *ast.CallExpr, lhs []ast.Expr) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || !isPackage(fn, metricPath, moneyPath) {
+		return
+	}
+
+	results := fn.Type().(*types.Signature).Results()
+	if results.Len() == 0 || !types.Identical(results.At(results.Len()-1).Type(), types.Universe.Lookup("error").Type()) {
+		return
+	}
+
+	if lhs != nil {
+		if len(lhs) != results.Len() {
+			return
+		}
+		if ident, ok := lhs[len(lhs)-1].(*ast.Ident); !ok || ident.Name != "_" {
+			return
+		}
+	}
+
+	c.pass.ReportRangef(call, "error returned by %s is ignored", displayName(fn, call))
+}
+
+func isPackage(fn *types.Func, paths ...string) bool {
+	if fn.Pkg() == nil {
+		return false
+	}
+	for _, p := range paths {
+		if fn.Pkg().Path() == p {
+			return true
+		}
+	}
+	return false
+}
+
+// displayName returns the name of the called function, e.g. metric.ParseQuantity or Quantity.Add,
+// or the called expression for methods of unexported types, e.g. metric.UnitConverter.Convert.
+func displayName(fn *types.Func, call *ast.CallExpr) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return fn.Pkg().Name() + "." + fn.Name()
+	}
+
+	t := recv.Type()
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+	if named, ok := t.(*types.Named); ok && named.Obj().Exported() {
+		return named.Obj().Name() + "." + fn.Name()
+	}
+	return types.ExprString(call.Fun)
+}
+
+// shortName returns the unit named as in its package's callers, e.g. metric.Meter for "github.com/IAmRadek/metric.Meter".
+func shortName(unit string) string {
+	return path.Base(unit)
+}
@@ -0,0 +1,12 @@
+package unitcheck_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric/analysis/unitcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), unitcheck.Analyzer, "units", "a")
+}
@@ -0,0 +1,323 @@
+// Command metric converts units, evaluates quantity arithmetic and splits money from the command line.
+//
+// Usage:
+//
+//	metric convert [-json] <amount> <unit> <target unit>
+//	metric eval [-json] <expression>
+//	metric money split [-json] <amount> <currency code> <parts>
+//	metric units [-json] [-system <name>]
+//
+// For example, "metric convert 5 km mi", "metric eval '3 m * 4 m'" and "metric money split 100.00 USD 3".
+// Besides the units of the metric package, the imperial units of the imperial package are available.
+// Flags come before the arguments; use "--" to pass a negative amount, e.g. "metric convert -- -40 °C K".
+//
+// The exit status is 0 on success, 1 on errors, 2 on invalid usage and 3 if the units are incompatible.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/expr"
+	_ "github.com/IAmRadek/metric/imperial" // registers the imperial units
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+const (
+	exitOK           = 0
+	exitError        = 1
+	exitUsage        = 2
+	exitIncompatible = 3
+)
+
+var errUsage = errors.New("invalid usage")
+
+const usage = `usage:
+  metric convert [-json] <amount> <unit> <target unit>
+  metric eval [-json] <expression>
+  metric money split [-json] <amount> <currency code> <parts>
+  metric units [-json] [-system <name>]
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command given by the arguments and returns the exit status.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	commands := map[string]func(*output, []string) error{
+		"convert": convert,
+		"eval":    eval,
+		"money":   moneyCommand,
+		"units":   units,
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "metric: unknown command %q\n%s", args[0], usage)
+		return exitUsage
+	}
+
+	out := &output{stdout: stdout, stderr: stderr}
+	return out.exit(command(out, args[1:]))
+}
+
+// output prints results and errors as text or JSON.
+type output struct {
+	stdout, stderr io.Writer
+	json           bool
+}
+
+// flags returns the flags of the command, including -json.
+func (o *output) flags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet("metric "+name, flag.ContinueOnError)
+	// Errors are reported by exit, with the usage of all commands.
+	flags.SetOutput(io.Discard)
+	flags.BoolVar(&o.json, "json", false, "print the result as JSON")
+	return flags
+}
+
+// parse parses the flags, reporting invalid ones as usage errors.
+func parse(flags *flag.FlagSet, args []string) ([]string, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, fmt.Errorf("%w: %v", errUsage, err)
+	}
+	return flags.Args(), nil
+}
+
+// print prints the value as JSON in the JSON mode and the text otherwise.
+func (o *output) print(value any, text string) error {
+	if !o.json {
+		_, err := fmt.Fprintln(o.stdout, text)
+		return err
+	}
+
+	encoder := json.NewEncoder(o.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// exit reports the error, if any, and returns the exit status for it.
+func (o *output) exit(err error) int {
+	if err == nil {
+		return exitOK
+	}
+
+	if o.json {
+		_ = json.NewEncoder(o.stderr).Encode(map[string]string{"error": err.Error()})
+	} else {
+		fmt.Fprintf(o.stderr, "metric: %v\n", err)
+	}
+
+	var incompatible metric.ErrIncompatibleMetric
+	switch {
+	case errors.Is(err, errUsage):
+		fmt.Fprint(o.stderr, usage)
+		return exitUsage
+	case errors.Is(err, metric.ErrNoConversion), errors.As(err, &incompatible):
+		return exitIncompatible
+	default:
+		return exitError
+	}
+}
+
+// quantityJSON is the JSON representation of a Quantity.
+type quantityJSON struct {
+	Amount float64 `json:"amount"`
+	Unit   string  `json:"unit"`
+	Name   string  `json:"name"`
+}
+
+// text returns the quantity as text, without the symbol of metric.One for dimensionless results, e.g. "0.5".
+func text(quantity metric.Quantity) string {
+	if quantity.Metric() == metric.One {
+		return fmt.Sprint(quantity.Amount())
+	}
+	return quantity.String()
+}
+
+func newQuantityJSON(quantity metric.Quantity) quantityJSON {
+	return quantityJSON{
+		Amount: quantity.Amount(),
+		Unit:   quantity.Metric().Symbol(),
+		Name:   quantity.Metric().Name(),
+	}
+}
+
+// convert converts "<amount> <unit>" to the target unit. The amount and unit may also be given as one argument, e.g. "5km".
+func convert(out *output, args []string) error {
+	args, err := parse(out.flags("convert"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("%w: convert takes an amount, a unit and a target unit", errUsage)
+	}
+
+	quantity, err := metric.ParseQuantity(strings.Join(args[:len(args)-1], " "))
+	if err != nil {
+		return err
+	}
+
+	target, err := metric.LookupUnit(args[len(args)-1])
+	if err != nil {
+		return err
+	}
+
+	converted, err := metric.UnitConverter.Convert(quantity, target)
+	if err != nil {
+		return fmt.Errorf("converting %s to %s: %w", quantity, target, err)
+	}
+
+	return out.print(newQuantityJSON(converted), text(converted))
+}
+
+// eval evaluates the expression formed by the arguments, e.g. "120 km/h * 0.9", with the expr package.
+func eval(out *output, args []string) error {
+	args, err := parse(out.flags("eval"), args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%w: eval takes an expression", errUsage)
+	}
+
+	result, err := expr.Evaluate(strings.Join(args, " "), nil)
+	if err != nil {
+		return err
+	}
+
+	return out.print(newQuantityJSON(result), text(result))
+}
+
+// moneyCommand runs the money subcommands; "split <amount> <currency code> <parts>" splits the amount into equal parts.
+func moneyCommand(out *output, args []string) error {
+	if len(args) == 0 || args[0] != "split" {
+		return fmt.Errorf("%w: the money command supports only split", errUsage)
+	}
+
+	args, err := parse(out.flags("money split"), args[1:])
+	if err != nil {
+		return err
+	}
+	if len(args) != 3 {
+		return fmt.Errorf("%w: money split takes an amount, a currency code and the number of parts", errUsage)
+	}
+
+	amount, err := decimal.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", args[0], err)
+	}
+
+	currency, ok := money.ISOCurrencies.Get(strings.ToUpper(args[1]))
+	if !ok {
+		return fmt.Errorf("unknown currency %q", args[1])
+	}
+
+	parts, err := strconv.Atoi(args[2])
+	if err != nil {
+		return fmt.Errorf("%w: invalid number of parts %q", errUsage, args[2])
+	}
+
+	split, err := money.NewMoneyFromDecimal(amount, currency).Split(parts)
+	if err != nil {
+		return err
+	}
+
+	type moneyJSON struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}
+
+	values := make([]moneyJSON, len(split))
+	lines := make([]string, len(split))
+	for i, part := range split {
+		values[i] = moneyJSON{Amount: part.Decimal().String(), Currency: currency.Code()}
+		lines[i] = fmt.Sprintf("%f", part)
+	}
+
+	return out.print(values, strings.Join(lines, "\n"))
+}
+
+// matchesSystem returns true if the name is the name of the system of units or its first word, e.g. "SI".
+func matchesSystem(system metric.SystemOfUnits, name string) bool {
+	if strings.EqualFold(system.Name(), name) {
+		return true
+	}
+
+	words := strings.Fields(system.Name())
+	return len(words) > 0 && strings.EqualFold(words[0], name)
+}
+
+// units lists the units of the system of units named by the -system flag, or of every system of units.
+func units(out *output, args []string) error {
+	flags := out.flags("units")
+	name := flags.String("system", "", "list only the units of the system of units with this name, e.g. SI")
+
+	args, err := parse(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return fmt.Errorf("%w: units takes no arguments", errUsage)
+	}
+
+	systems := metric.SystemsOfUnits()
+
+	if name := *name; name != "" {
+		var found []metric.SystemOfUnits
+		for _, system := range systems {
+			if matchesSystem(system, name) {
+				found = append(found, system)
+			}
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("unknown system of units %q", name)
+		}
+		systems = found
+	}
+
+	type unitJSON struct {
+		Name       string `json:"name"`
+		Symbol     string `json:"symbol"`
+		System     string `json:"system"`
+		Definition string `json:"definition"`
+	}
+
+	var values []unitJSON
+	var b strings.Builder
+	table := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(table, "SYMBOL\tNAME\tSYSTEM")
+
+	for _, system := range systems {
+		for _, unit := range system.Units() {
+			values = append(values, unitJSON{
+				Name:       unit.Name(),
+				Symbol:     unit.Symbol(),
+				System:     system.Name(),
+				Definition: unit.Definition(),
+			})
+			fmt.Fprintf(table, "%s\t%s\t%s\n", unit.Symbol(), unit.Name(), system.Name())
+		}
+	}
+
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	return out.print(values, strings.TrimSuffix(b.String(), "\n"))
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		status   int
+		expected string
+	}{
+		{name: "Convert", args: []string{"convert", "5", "km", "m"}, status: exitOK, expected: "5000 m\n"},
+		{name: "ConvertToMiles", args: []string{"convert", "1.609344", "km", "mi"}, status: exitOK, expected: "1 mi\n"},
+		{name: "ConvertFahrenheit", args: []string{"convert", "--", "-40", "°F", "°C"}, status: exitOK, expected: "-40 °C\n"},
+		{name: "ConvertGrams", args: []string{"convert", "250", "g", "kg"}, status: exitOK, expected: "0.25 kg\n"},
+		{name: "ConvertAlias", args: []string{"convert", "1", "mi/h", "m/s"}, status: exitOK, expected: "0.44704 m/s\n"},
+		{name: "ConvertPoundsToGrams", args: []string{"convert", "1", "lb", "g"}, status: exitOK, expected: "453.59237 g\n"},
+		{name: "ConvertPoundsToMilligrams", args: []string{"convert", "1", "lb", "mg"}, status: exitOK, expected: "453592.37 mg\n"},
+		{name: "ConvertBytes", args: []string{"convert", "2048", "byte", "KiB"}, status: exitOK, expected: "2 KiB\n"},
+		{name: "ConvertAmbiguousUnit", args: []string{"convert", "2048", "B", "KiB"}, status: exitError},
+		{name: "ConvertAttached", args: []string{"convert", "1.5h", "min"}, status: exitOK, expected: "90 min\n"},
+		{name: "ConvertNegative", args: []string{"convert", "--", "-40", "°C", "°C"}, status: exitOK, expected: "-40 °C\n"},
+		{name: "ConvertIncompatible", args: []string{"convert", "5", "m", "s"}, status: exitIncompatible},
+		{name: "ConvertUnknownUnit", args: []string{"convert", "5", "furlong", "m"}, status: exitError},
+		{name: "ConvertMissingTarget", args: []string{"convert", "5"}, status: exitUsage},
+		{name: "Eval", args: []string{"eval", "3 m * 4 m"}, status: exitOK, expected: "12 m²\n"},
+		{name: "EvalPrecedence", args: []string{"eval", "1 m", "+", "2 m", "*", "3"}, status: exitOK, expected: "7 m\n"},
+		{name: "EvalRate", args: []string{"eval", "(5 GB) / (20 s)"}, status: exitOK, expected: "0.25 GB/s\n"},
+		{name: "EvalDimensionless", args: []string{"eval", "2^-1"}, status: exitOK, expected: "0.5\n"},
+		{name: "EvalIncompatible", args: []string{"eval", "2 m + 3 s"}, status: exitIncompatible},
+		{name: "EvalMissingOperand", args: []string{"eval", "2 m +"}, status: exitError},
+		{name: "MoneySplit", args: []string{"money", "split", "100.00", "USD", "3"}, status: exitOK, expected: "33.34 USD\n33.33 USD\n33.33 USD\n"},
+		{name: "MoneySplitZeroParts", args: []string{"money", "split", "100.00", "USD", "0"}, status: exitError},
+		{name: "MoneyUnknownSubcommand", args: []string{"money", "sum"}, status: exitUsage},
+		{name: "UnknownCommand", args: []string{"frobnicate"}, status: exitUsage},
+		{name: "UnknownFlag", args: []string{"units", "-verbose"}, status: exitUsage},
+		{name: "NoCommand", args: nil, status: exitUsage},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, &stdout, &stderr)
+
+			is.Equal(status, tt.status)
+			if tt.status == exitOK {
+				is.Equal(stdout.String(), tt.expected)
+				is.Equal(stderr.String(), "")
+			} else {
+				is.True(stderr.Len() > 0)
+			}
+		})
+	}
+}
+
+func TestRunJSON(t *testing.T) {
+	t.Run("Convert", func(t *testing.T) {
+		is := isser.New(t)
+
+		var stdout, stderr bytes.Buffer
+		is.Equal(run([]string{"convert", "-json", "2", "min", "s"}, &stdout, &stderr), exitOK)
+
+		var result quantityJSON
+		is.NoErr(json.Unmarshal(stdout.Bytes(), &result))
+		is.Equal(result, quantityJSON{Amount: 120, Unit: "s", Name: "second"})
+	})
+
+	t.Run("MoneySplit", func(t *testing.T) {
+		is := isser.New(t)
+
+		var stdout, stderr bytes.Buffer
+		is.Equal(run([]string{"money", "split", "-json", "10", "eur", "4"}, &stdout, &stderr), exitOK)
+
+		var result []struct {
+			Amount   string `json:"amount"`
+			Currency string `json:"currency"`
+		}
+		is.NoErr(json.Unmarshal(stdout.Bytes(), &result))
+		is.Equal(len(result), 4)
+		is.Equal(result[0].Amount, "2.50")
+		is.Equal(result[0].Currency, "EUR")
+	})
+
+	t.Run("Units", func(t *testing.T) {
+		is := isser.New(t)
+
+		var stdout, stderr bytes.Buffer
+		is.Equal(run([]string{"units", "-json", "-system", "si"}, &stdout, &stderr), exitOK)
+
+		var result []struct {
+			Symbol string `json:"symbol"`
+			System string `json:"system"`
+		}
+		is.NoErr(json.Unmarshal(stdout.Bytes(), &result))
+		is.True(len(result) > 0)
+		for _, unit := range result {
+			is.Equal(unit.System, "SI")
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		is := isser.New(t)
+
+		var stdout, stderr bytes.Buffer
+		is.Equal(run([]string{"convert", "-json", "5", "m", "s"}, &stdout, &stderr), exitIncompatible)
+
+		var result map[string]string
+		is.NoErr(json.Unmarshal(stderr.Bytes(), &result))
+		is.True(strings.Contains(result["error"], "no conversion found"))
+		is.Equal(stdout.Len(), 0)
+	})
+}
+
+func TestRunUnits(t *testing.T) {
+	is := isser.New(t)
+
+	var stdout, stderr bytes.Buffer
+	is.Equal(run([]string{"units", "-system", "IEC"}, &stdout, &stderr), exitOK)
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	is.True(strings.HasPrefix(lines[0], "SYMBOL"))
+	is.True(strings.HasPrefix(lines[2], "B "))
+
+	stdout.Reset()
+	is.Equal(run([]string{"units", "-system", "imperial"}, &stdout, &stderr), exitOK)
+	is.True(strings.Contains(stdout.String(), "mi "))
+
+	is.Equal(run([]string{"units", "-system", "martian"}, &stdout, &stderr), exitError)
+}
+
+func TestMatchesSystem(t *testing.T) {
+	is := isser.New(t)
+
+	is.True(matchesSystem(metric.SISystemOfUnits, "si"))
+	is.True(!matchesSystem(metric.SISystemOfUnits, "imperial"))
+	is.True(!matchesSystem(metric.NewSystemOfUnits("", ""), "SI"))
+	is.True(!matchesSystem(metric.NewSystemOfUnits(" ", ""), "SI"))
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// generate returns the formatted Go source of the units of the spec, or of their tests if test is true.
+// The source names the spec file in its "Code generated" header.
+func generate(spec *Spec, source string, test bool) ([]byte, error) {
+	tmpl := unitsTemplate
+	if test {
+		tmpl = testTemplate
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		*Spec
+		Source string
+	}{spec, source}); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated source: %w", err)
+	}
+
+	return formatted, nil
+}
+
+var funcs = template.FuncMap{
+	"quote": strconv.Quote,
+	// factor returns the factor of a conversion, parenthesizing fractions, e.g. "(5.0/9)".
+	"factor": func(factor Number) string {
+		if s := factor.Go(); strings.Contains(s, "/") {
+			return "(" + s + ")"
+		}
+		return factor.Go()
+	},
+	// plus and minus add and subtract the offset of a conversion, if any, e.g. " - 160.0/9" for -160/9.
+	"plus": func(offset Number) string {
+		return signed(offset, "+", "-")
+	},
+	"minus": func(offset Number) string {
+		return signed(offset, "-", "+")
+	},
+	"system": func(system string) string {
+		if system == "" {
+			return "nil"
+		}
+		return system
+	},
+	"to": func(u UnitSpec, c ConversionSpec) string {
+		to, _ := u.conversionVars(c)
+		return to
+	},
+	"from": func(u UnitSpec, c ConversionSpec) string {
+		_, from := u.conversionVars(c)
+		return from
+	},
+}
+
+func signed(offset Number, positive, negative string) string {
+	switch offset.Sign() {
+	case 1:
+		return " " + positive + " " + offset.Go()
+	case -1:
+		return " " + negative + " " + Number{offset.value().Neg(offset.value())}.Go()
+	}
+	return ""
+}
+
+var unitsTemplate = template.Must(template.New("units").Funcs(funcs).Parse(`// Code generated by metricgen from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"github.com/IAmRadek/metric"
+)
+{{if .Systems}}
+var (
+{{- range .Systems}}
+	{{.Var}} = metric.NewSystemOfUnits({{quote .Name}}, {{quote .Body}})
+{{- end}}
+)
+{{end}}{{if .Registers}}
+// init registers the systems of units and the aliases of the units, so that metric.LookupUnit finds the units.
+func init() {
+{{- range .Systems}}
+	metric.RegisterSystemOfUnits({{.Var}})
+{{- end}}
+{{- range .Units}}{{if .RegisteredAliases}}
+	metric.RegisterAlias({{.Var}}{{range .RegisteredAliases}}, {{quote .}}{{end}})
+{{- end}}{{end}}
+}
+{{end}}
+var (
+{{- range .Units}}
+	// {{.Var}} is the {{.Name}} ({{.Symbol}}).
+{{- if .Terms}}
+	{{.Var}} = metric.NewDerivedUnit({{quote .Name}}, {{quote .Definition}}, {{quote .Symbol}}, {{system .System}},
+{{- range .Terms}}
+		metric.NewDerivedUnitTerm({{.Unit}}, {{.Exponent}}),
+{{- end}}
+	)
+{{- else}}
+	{{.Var}} = metric.NewBaseUnit({{quote .Name}}, {{quote .Definition}}, {{quote .Symbol}}, {{system .System}})
+{{- end}}
+{{- end}}
+)
+
+var (
+{{- range $unit := .Units}}{{range .Conversions}}
+	// {{to $unit .}} converts {{$unit.Var}} to {{.To}}.
+	{{to $unit .}} = metric.NewStandardConversion({{$unit.Var}}, {{.To}}, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*{{factor .Factor}}{{plus .Offset}}, {{.To}}), nil
+	})
+
+	// {{from $unit .}} converts {{.To}} to {{$unit.Var}}.
+	{{from $unit .}} = metric.NewStandardConversion({{.To}}, {{$unit.Var}}, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity({{if .Offset.Sign}}(quantity.Amount(){{minus .Offset}}){{else}}quantity.Amount(){{end}}/{{factor .Factor}}, {{$unit.Var}}), nil
+	})
+{{end}}{{end -}}
+)
+
+`))
+
+var testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(`// Code generated by metricgen from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+)
+
+func TestLookupUnit(t *testing.T) {
+	tests := []struct {
+		symbol string
+		want   metric.Unit
+	}{
+{{- range .Units}}{{$var := .Var}}{{range .LookupKeys}}
+		{ {{- quote .}}, {{$var}}},
+{{- end}}{{end}}
+	}
+
+	for _, tt := range tests {
+		got, err := metric.LookupUnit(tt.symbol)
+		if err != nil {
+			t.Fatalf("metric.LookupUnit(%q) error: %v", tt.symbol, err)
+		}
+		if got != tt.want {
+			t.Errorf("metric.LookupUnit(%q) = %s, want %s", tt.symbol, got.Name(), tt.want.Name())
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		unit   metric.Unit
+		target metric.Unit
+		want   float64
+	}{
+{{- range $unit := .Units}}{{range .Conversions}}
+		{ {{- $unit.Var}}, {{.To}}, {{factor .Factor}}{{plus .Offset}}},
+{{- end}}{{end}}
+	}
+
+	for _, tt := range tests {
+		converted, err := metric.UnitConverter.Convert(metric.NewQuantity(1, tt.unit), tt.target)
+		if err != nil {
+			t.Fatalf("converting 1 %s to %s: %v", tt.unit, tt.target, err)
+		}
+		if !approximately(converted.Amount(), tt.want) {
+			t.Errorf("1 %s = %v %s, want %v", tt.unit, converted.Amount(), tt.target, tt.want)
+		}
+
+		back, err := metric.UnitConverter.Convert(converted, tt.unit)
+		if err != nil {
+			t.Fatalf("converting %s back to %s: %v", converted, tt.unit, err)
+		}
+		if !approximately(back.Amount(), 1) {
+			t.Errorf("%s = %v %s, want 1", converted, back.Amount(), tt.unit)
+		}
+	}
+}
+
+{{- if .Systems}}
+
+func TestSystemsOfUnits(t *testing.T) {
+	registered := make(map[metric.SystemOfUnits]bool)
+	for _, system := range metric.SystemsOfUnits() {
+		registered[system] = true
+	}
+
+	for _, system := range []metric.SystemOfUnits{
+{{- range .Systems}}
+		{{.Var}},
+{{- end}}
+	} {
+		if !registered[system] {
+			t.Errorf("%s is not registered", system.Name())
+		}
+	}
+}
+{{- end}}
+
+func approximately(got, want float64) bool {
+	return math.Abs(got-want) <= 1e-9*math.Max(1, math.Abs(want))
+}
+`))
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	isser "github.com/matryer/is"
+)
+
+// TestGenerate checks that the generated files of the imperial package are up to date with its spec.
+func TestGenerate(t *testing.T) {
+	is := isser.New(t)
+
+	dir := filepath.Join("..", "..", "imperial")
+
+	f, err := os.Open(filepath.Join(dir, "units.json"))
+	is.NoErr(err)
+	defer f.Close()
+
+	spec, err := readSpec(f, "json")
+	is.NoErr(err)
+
+	for file, test := range map[string]bool{"units_gen.go": false, "units_gen_test.go": true} {
+		generated, err := generate(spec, "units.json", test)
+		is.NoErr(err)
+
+		existing, err := os.ReadFile(filepath.Join(dir, file))
+		is.NoErr(err)
+		is.Equal(string(generated), string(existing)) // run go generate ./imperial
+	}
+}
+
+func TestGenerateFiles(t *testing.T) {
+	is := isser.New(t)
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "units.json")
+	is.NoErr(os.WriteFile(specPath, []byte(`{"package": "nautical", "units": [
+		{"name": "nautical mile", "symbol": "nmi", "conversion": {"to": "metric.Meter", "factor": 1852}},
+		{"name": "knot", "symbol": "kn", "terms": [{"unit": "NauticalMile", "exponent": 1}, {"unit": "metric.Hour", "exponent": -1}],
+		 "conversion": {"to": "metric.Speed", "factor": 0.5144444444444445}, "aliases": ["kt"]}
+	]}`), 0o644))
+
+	out := filepath.Join(dir, "nautical.go")
+	is.NoErr(generateFiles(specPath, out, true))
+
+	source, err := os.ReadFile(out)
+	is.NoErr(err)
+	is.True(strings.HasPrefix(string(source), "// Code generated by metricgen from units.json; DO NOT EDIT.\n\npackage nautical\n"))
+	is.True(strings.Contains(string(source), `Knot = metric.NewDerivedUnit("knot", "", "kn", nil,`))
+	is.True(strings.Contains(string(source), `metric.RegisterAlias(Knot, "kn", "knot", "kt")`)) // no system of units
+	is.True(strings.Contains(string(source), "KnotToSpeed = metric.NewStandardConversion(Knot, metric.Speed,"))
+
+	_, err = os.Stat(filepath.Join(dir, "nautical_test.go"))
+	is.NoErr(err)
+
+	is.True(generateFiles(specPath, filepath.Join(dir, "nautical_test.go"), false) != nil) // the output must not be a test file
+}
+
+func TestGenerateFilesYAML(t *testing.T) {
+	is := isser.New(t)
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "units.yaml")
+	is.NoErr(os.WriteFile(specPath, []byte(`package: nautical
+systems:
+  - {var: NauticalSystemOfUnits, name: Nautical, body: IHO}
+units:
+  - name: nautical mile
+    symbol: nmi
+    system: NauticalSystemOfUnits
+    conversions:
+      - {to: metric.Meter, factor: 1852}
+      - {to: Cable, factor: 10}
+  - name: cable
+    symbol: cb
+    system: NauticalSystemOfUnits
+`), 0o644))
+
+	out := filepath.Join(dir, "nautical.go")
+	is.NoErr(generateFiles(specPath, out, true))
+
+	source, err := os.ReadFile(out)
+	is.NoErr(err)
+	is.True(strings.HasPrefix(string(source), "// Code generated by metricgen from units.yaml; DO NOT EDIT.\n"))
+	is.True(strings.Contains(string(source), "\tmetric.RegisterSystemOfUnits(NauticalSystemOfUnits)\n"))
+	is.True(strings.Contains(string(source), "NauticalMileToMeter = metric.NewStandardConversion(NauticalMile, metric.Meter,"))
+	is.True(strings.Contains(string(source), "NauticalMileToCable = metric.NewStandardConversion(NauticalMile, Cable,"))
+	is.True(strings.Contains(string(source), "CableToNauticalMile = metric.NewStandardConversion(Cable, NauticalMile,"))
+
+	test, err := os.ReadFile(filepath.Join(dir, "nautical_test.go"))
+	is.NoErr(err)
+	is.True(strings.Contains(string(test), "func TestSystemsOfUnits(t *testing.T) {"))
+
+	is.True(generateFiles(filepath.Join(dir, "units.txt"), out, false) != nil) // unknown spec format
+}
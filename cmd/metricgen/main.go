@@ -0,0 +1,108 @@
+// Command metricgen generates Go source declaring units and their conversions
+// from a JSON, YAML or TOML spec, so that packs of domain-specific units can be maintained declaratively.
+// The generated package registers its systems of units and the aliases of its units, so that metric.LookupUnit
+// finds the units once the package is imported.
+//
+// Usage:
+//
+//	metricgen [-spec units.json] [-out units_gen.go] [-test=true]
+//
+// The format of the spec is given by its extension: .json, .yaml, .yml or .toml.
+//
+// It is meant to be run by go generate, e.g. with the directive
+//
+//	//go:generate go run github.com/IAmRadek/metric/cmd/metricgen -spec units.json -out units_gen.go
+//
+// Besides the units, metricgen writes a test file next to the output, e.g. units_gen_test.go,
+// checking the lookup of every symbol, name and alias and the round trip of every conversion.
+// See the Spec type for the format of the spec and the imperial package for an example.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run generates the files given by the arguments and returns the exit status.
+func run(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("metricgen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	specPath := flags.String("spec", "units.json", "the JSON, YAML or TOML spec of the units")
+	out := flags.String("out", "units_gen.go", "the generated Go file")
+	test := flags.Bool("test", true, "also generate a test file named after the output, e.g. units_gen_test.go")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	if err := generateFiles(*specPath, *out, *test); err != nil {
+		fmt.Fprintf(stderr, "metricgen: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// generateFiles reads the spec and writes the units to out and, if test is true, their tests next to it.
+func generateFiles(specPath, out string, test bool) error {
+	if !strings.HasSuffix(out, ".go") || strings.HasSuffix(out, "_test.go") {
+		return errors.New("the output must be a non-test .go file")
+	}
+
+	format, err := specFormat(specPath)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	spec, err := readSpec(f, format)
+	if err != nil {
+		return fmt.Errorf("%s: %w", specPath, err)
+	}
+
+	files := map[string]bool{out: false}
+	if test {
+		files[strings.TrimSuffix(out, ".go")+"_test.go"] = true
+	}
+
+	for path, test := range files {
+		source, err := generate(spec, filepath.Base(specPath), test)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(path, source); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFile writes the file unless it already has the content, to keep its modification time.
+func writeFile(path string, content []byte) error {
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
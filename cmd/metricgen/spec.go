@@ -0,0 +1,501 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+var errInvalidSpec = errors.New("invalid spec")
+
+// Spec declares a catalog of units in JSON, YAML or TOML, e.g.
+//
+//	{
+//	  "package": "imperial",
+//	  "systems": [{"var": "ImperialSystemOfUnits", "name": "Imperial", "body": "UK Weights and Measures Act 1985"}],
+//	  "units": [{
+//	    "name": "mile", "symbol": "mi", "definition": "The mile is equal to 1609.344 meters",
+//	    "system": "ImperialSystemOfUnits", "conversion": {"to": "metric.Meter", "factor": 1609.344}, "aliases": ["miles"]
+//	  }]
+//	}
+//
+// or the same in YAML
+//
+//	package: imperial
+//	units:
+//	  - name: mile
+//	    symbol: mi
+//	    conversions:
+//	      - {to: metric.Meter, factor: 1609.344}
+//	      - {to: Yard, factor: 1760}
+type Spec struct {
+	// Package is the name of the generated package.
+	Package string `json:"package" yaml:"package" toml:"package"`
+
+	Systems []SystemSpec `json:"systems,omitempty" yaml:"systems,omitempty" toml:"systems,omitempty"`
+	Units   []UnitSpec   `json:"units" yaml:"units" toml:"units"`
+}
+
+// SystemSpec declares a new metric.SystemOfUnits.
+type SystemSpec struct {
+	Var  string `json:"var" yaml:"var" toml:"var"`
+	Name string `json:"name" yaml:"name" toml:"name"`
+	Body string `json:"body" yaml:"body" toml:"body"`
+}
+
+// UnitSpec declares a unit. Units with terms are metric.DerivedUnits, the others base units.
+//
+// References to units and systems of units are the variables of the spec, e.g. "Mile",
+// or the exported variables of the metric package, e.g. "metric.Meter".
+type UnitSpec struct {
+	// Var is the name of the exported variable. Defaults to the name in camel case, e.g. MilePerHour for "mile per hour".
+	Var        string `json:"var,omitempty" yaml:"var,omitempty" toml:"var,omitempty"`
+	Name       string `json:"name" yaml:"name" toml:"name"`
+	Symbol     string `json:"symbol" yaml:"symbol" toml:"symbol"`
+	Definition string `json:"definition,omitempty" yaml:"definition,omitempty" toml:"definition,omitempty"`
+
+	// System references the system of units of the unit, or is empty for none.
+	System string `json:"system,omitempty" yaml:"system,omitempty" toml:"system,omitempty"`
+
+	Terms []TermSpec `json:"terms,omitempty" yaml:"terms,omitempty" toml:"terms,omitempty"`
+
+	// Conversion is a shorthand for a single conversion; it is moved to the front of Conversions.
+	Conversion  *ConversionSpec  `json:"conversion,omitempty" yaml:"conversion,omitempty" toml:"conversion,omitempty"`
+	Conversions []ConversionSpec `json:"conversions,omitempty" yaml:"conversions,omitempty" toml:"conversions,omitempty"`
+
+	// Aliases are alternative symbols and names registered with metric.RegisterAlias, so that metric.LookupUnit accepts them.
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty" toml:"aliases,omitempty"`
+}
+
+// TermSpec is a unit raised to an exponent.
+type TermSpec struct {
+	Unit     string `json:"unit" yaml:"unit" toml:"unit"`
+	Exponent int    `json:"exponent" yaml:"exponent" toml:"exponent"`
+}
+
+// ConversionSpec converts the unit to another one as target = unit*factor + offset, and back.
+// Conversions through a chain of them are generated as well, e.g. from the ounce to the kilogram through the pound.
+type ConversionSpec struct {
+	To     string `json:"to" yaml:"to" toml:"to"`
+	Factor Number `json:"factor" yaml:"factor" toml:"factor"`
+	Offset Number `json:"offset,omitempty" yaml:"offset,omitempty" toml:"offset,omitempty"`
+}
+
+// Number is an exact factor or offset of a conversion, written as a number, e.g. 0.3048, or as a fraction
+// in a string, e.g. "5/9", for factors without an exact decimal form. It is generated as a constant expression,
+// e.g. 5.0/9, which the compiler evaluates exactly.
+type Number struct {
+	rat *big.Rat
+}
+
+// newNumber returns the number num/den.
+func newNumber(num, den int64) Number {
+	return Number{big.NewRat(num, den)}
+}
+
+func parseNumber(s string) (Number, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return Number{}, fmt.Errorf("invalid number %q", s)
+	}
+	return Number{rat}, nil
+}
+
+// value returns a copy of the number as a big.Rat, zero if the number is not set.
+func (n Number) value() *big.Rat {
+	if n.rat == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(n.rat)
+}
+
+// Sign returns -1, 0 or 1 as the number is negative, zero or positive.
+func (n Number) Sign() int {
+	return n.value().Sign()
+}
+
+// equal returns true if both numbers have the same value, e.g. 0.5 and "1/2".
+func (n Number) equal(other Number) bool {
+	return n.value().Cmp(other.value()) == 0
+}
+
+// Go returns the number as a Go constant expression: an integer, a decimal or a fraction of them, e.g. "12", "0.3048" or "5.0/9".
+func (n Number) Go() string {
+	rat := n.value()
+	if rat.IsInt() {
+		return rat.Num().String()
+	}
+	if digits, ok := decimalDigits(rat.Denom()); ok {
+		return strings.TrimRight(rat.FloatString(digits), "0")
+	}
+	return rat.Num().String() + ".0/" + rat.Denom().String()
+}
+
+// decimalDigits returns the number of decimal digits of fractions with the denominator, if they have a finite decimal form.
+func decimalDigits(denominator *big.Int) (int, bool) {
+	d := new(big.Int).Set(denominator)
+	twos, fives := 0, 0
+	for _, f := range []struct {
+		factor int64
+		count  *int
+	}{{2, &twos}, {5, &fives}} {
+		factor, remainder := big.NewInt(f.factor), new(big.Int)
+		for {
+			quotient, r := new(big.Int).QuoRem(d, factor, remainder)
+			if r.Sign() != 0 {
+				break
+			}
+			d = quotient
+			*f.count++
+		}
+	}
+	if d.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+func (n *Number) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	number, err := parseNumber(s)
+	if err != nil {
+		return err
+	}
+	*n = number
+	return nil
+}
+
+func (n *Number) UnmarshalYAML(node *yaml.Node) error {
+	number, err := parseNumber(node.Value)
+	if err != nil {
+		return err
+	}
+	*n = number
+	return nil
+}
+
+func (n *Number) UnmarshalTOML(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Errorf("invalid number %v", value)
+	}
+
+	number, err := parseNumber(s)
+	if err != nil {
+		return err
+	}
+	*n = number
+	return nil
+}
+
+// specFormats maps the extensions of spec files to their formats.
+var specFormats = map[string]string{
+	".json": "json",
+	".yaml": "yaml",
+	".yml":  "yaml",
+	".toml": "toml",
+}
+
+// specFormat returns the format of the spec file, given by its extension.
+func specFormat(path string) (string, error) {
+	format, ok := specFormats[strings.ToLower(filepath.Ext(path))]
+	if !ok {
+		return "", fmt.Errorf("%w: %s is not a .json, .yaml, .yml or .toml file", errInvalidSpec, path)
+	}
+	return format, nil
+}
+
+// readSpec decodes the spec in the format, "json", "yaml" or "toml", and validates it, filling in the defaults.
+// Unknown fields are rejected in every format.
+func readSpec(r io.Reader, format string) (*Spec, error) {
+	var spec Spec
+	if err := decodeSpec(r, format, &spec); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidSpec, err)
+	}
+
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+func decodeSpec(r io.Reader, format string, spec *Spec) error {
+	switch format {
+	case "json":
+		decoder := json.NewDecoder(r)
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(spec)
+	case "yaml":
+		decoder := yaml.NewDecoder(r)
+		decoder.KnownFields(true)
+		return decoder.Decode(spec)
+	case "toml":
+		metadata, err := toml.NewDecoder(r).Decode(spec)
+		if err != nil {
+			return err
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			keys := make([]string, len(undecoded))
+			for i, key := range undecoded {
+				keys[i] = key.String()
+			}
+			sort.Strings(keys)
+			return fmt.Errorf("unknown fields %s", strings.Join(keys, ", "))
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown format %q", format)
+}
+
+func (s *Spec) validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", errInvalidSpec, fmt.Sprintf(format, args...))
+	}
+
+	if !token.IsIdentifier(s.Package) {
+		return invalid("package %q is not a valid package name", s.Package)
+	}
+	if len(s.Units) == 0 {
+		return invalid("no units")
+	}
+
+	declared := make(map[string]bool)
+	declare := func(name string) error {
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			return invalid("%q is not an exported identifier", name)
+		}
+		if declared[name] {
+			return invalid("%s is declared twice", name)
+		}
+		declared[name] = true
+		return nil
+	}
+
+	systems := make(map[string]bool)
+	for _, system := range s.Systems {
+		if err := declare(system.Var); err != nil {
+			return err
+		}
+		if system.Name == "" {
+			return invalid("system %s has no name", system.Var)
+		}
+		systems[system.Var] = true
+	}
+
+	units := make(map[string]bool)
+	for i := range s.Units {
+		unit := &s.Units[i]
+		if unit.Name == "" || unit.Symbol == "" {
+			return invalid("unit #%d has no name or symbol", i+1)
+		}
+		if unit.Var == "" {
+			unit.Var = camelCase(unit.Name)
+		}
+		if unit.Conversion != nil {
+			unit.Conversions = append([]ConversionSpec{*unit.Conversion}, unit.Conversions...)
+			unit.Conversion = nil
+		}
+		if err := declare(unit.Var); err != nil {
+			return err
+		}
+		units[unit.Var] = true
+	}
+
+	keys := make(map[string]string)
+	for _, unit := range s.Units {
+		if unit.System != "" && !systems[unit.System] && !isMetricReference(unit.System) {
+			return invalid("unit %s references the unknown system of units %q", unit.Var, unit.System)
+		}
+
+		for _, term := range unit.Terms {
+			if !units[term.Unit] && !isMetricReference(term.Unit) {
+				return invalid("unit %s references the unknown unit %q", unit.Var, term.Unit)
+			}
+			if term.Exponent == 0 {
+				return invalid("unit %s has a term with a zero exponent", unit.Var)
+			}
+		}
+
+		targets := make(map[string]bool)
+		for _, c := range unit.Conversions {
+			if !units[c.To] && !isMetricReference(c.To) {
+				return invalid("unit %s converts to the unknown unit %q", unit.Var, c.To)
+			}
+			if c.To == unit.Var {
+				return invalid("unit %s converts to itself", unit.Var)
+			}
+			if targets[c.To] {
+				return invalid("unit %s converts to %s twice", unit.Var, c.To)
+			}
+			targets[c.To] = true
+			if c.Factor.Sign() == 0 {
+				return invalid("unit %s has a zero conversion factor", unit.Var)
+			}
+			to, from := unit.conversionVars(c)
+			if err := declare(to); err != nil {
+				return err
+			}
+			if err := declare(from); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range unit.LookupKeys() {
+			if other, ok := keys[key]; ok && other != unit.Var {
+				return invalid("%q refers to both %s and %s", key, other, unit.Var)
+			}
+			keys[key] = unit.Var
+		}
+	}
+
+	for i, chained := range s.chainedConversions() {
+		for _, c := range chained {
+			to, from := s.Units[i].conversionVars(c)
+			if err := declare(to); err != nil {
+				return err
+			}
+			if err := declare(from); err != nil {
+				return err
+			}
+		}
+		s.Units[i].Conversions = append(s.Units[i].Conversions, chained...)
+	}
+
+	return nil
+}
+
+// chainedConversions returns, by index of the unit, the conversions the units have through a chain of declared conversions
+// in either direction, e.g. from the ounce to the kilogram through the pound, so that every pair of connected units
+// converts directly. A conversion between two units of the spec belongs to the one declared first.
+func (s *Spec) chainedConversions() map[int][]ConversionSpec {
+	type edge struct {
+		to             string
+		factor, offset *big.Rat
+	}
+
+	edges := make(map[string][]edge)
+	direct := make(map[[2]string]bool)
+	index := make(map[string]int)
+	for i, unit := range s.Units {
+		index[unit.Var] = i
+		for _, c := range unit.Conversions {
+			factor, offset := c.Factor.value(), c.Offset.value()
+			edges[unit.Var] = append(edges[unit.Var], edge{c.To, factor, offset})
+
+			// The inverse of target = unit*factor + offset is unit = target/factor - offset/factor.
+			inverse := new(big.Rat).Inv(factor)
+			edges[c.To] = append(edges[c.To], edge{unit.Var, inverse, new(big.Rat).Neg(new(big.Rat).Mul(offset, inverse))})
+
+			direct[[2]string{unit.Var, c.To}], direct[[2]string{c.To, unit.Var}] = true, true
+		}
+	}
+
+	chained := make(map[int][]ConversionSpec)
+	for i, unit := range s.Units {
+		// A breadth-first search gives the shortest chain to every connected unit.
+		reached := map[string]edge{unit.Var: {unit.Var, big.NewRat(1, 1), new(big.Rat)}}
+		queue := []string{unit.Var}
+		for len(queue) > 0 {
+			current := reached[queue[0]]
+			queue = queue[1:]
+
+			for _, e := range edges[current.to] {
+				if _, ok := reached[e.to]; ok {
+					continue
+				}
+				// Following the edge after the chain gives e.factor*(unit*current.factor + current.offset) + e.offset.
+				factor := new(big.Rat).Mul(current.factor, e.factor)
+				offset := new(big.Rat).Add(new(big.Rat).Mul(current.offset, e.factor), e.offset)
+				reached[e.to] = edge{e.to, factor, offset}
+				queue = append(queue, e.to)
+
+				if j, ok := index[e.to]; ok && j < i || direct[[2]string{unit.Var, e.to}] {
+					continue
+				}
+				chained[i] = append(chained[i], ConversionSpec{To: e.to, Factor: Number{factor}, Offset: Number{offset}})
+			}
+		}
+	}
+
+	return chained
+}
+
+// LookupKeys returns the symbol, name and aliases of the unit, without duplicates.
+func (u UnitSpec) LookupKeys() []string {
+	var keys []string
+	seen := make(map[string]bool)
+	for _, key := range append([]string{u.Symbol, u.Name}, u.Aliases...) {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// RegisteredAliases returns the keys registered with metric.RegisterAlias: the aliases, and the symbol and name
+// of a unit without a system of units, which metric.LookupUnit would not find otherwise.
+func (u UnitSpec) RegisteredAliases() []string {
+	if u.System == "" {
+		return u.LookupKeys()
+	}
+	return u.Aliases
+}
+
+// Registers returns true if the generated package registers systems of units or aliases.
+func (s *Spec) Registers() bool {
+	for _, unit := range s.Units {
+		if len(unit.RegisteredAliases()) > 0 {
+			return true
+		}
+	}
+	return len(s.Systems) > 0
+}
+
+// conversionVars returns the names of the variables of the conversions to and from the target unit,
+// e.g. MileToMeter and MeterToMile.
+func (u UnitSpec) conversionVars(c ConversionSpec) (to, from string) {
+	target := strings.TrimPrefix(c.To, "metric.")
+	return u.Var + "To" + target, target + "To" + u.Var
+}
+
+// isMetricReference returns true for references to exported variables of the metric package, e.g. "metric.Meter".
+func isMetricReference(reference string) bool {
+	name, ok := strings.CutPrefix(reference, "metric.")
+	return ok && token.IsIdentifier(name) && token.IsExported(name)
+}
+
+// camelCase turns the name into an exported identifier, e.g. "degree Fahrenheit" into DegreeFahrenheit.
+func camelCase(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	return b.String()
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	isser "github.com/matryer/is"
+)
+
+func TestReadSpec(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		is := isser.New(t)
+
+		spec, err := readSpec(strings.NewReader(`{"package": "nautical", "units": [
+			{"name": "nautical mile", "symbol": "nmi", "conversion": {"to": "metric.Meter", "factor": 1852}},
+			{"name": "knot", "symbol": "kn", "terms": [{"unit": "NauticalMile", "exponent": 1}, {"unit": "metric.Hour", "exponent": -1}]}
+		]}`), "json")
+		is.NoErr(err)
+
+		is.Equal(spec.Units[0].Var, "NauticalMile")
+		is.Equal(spec.Units[1].Var, "Knot")
+		is.Equal(spec.Units[0].Conversion, nil)
+		is.Equal(conversions(spec.Units[0]), []string{"metric.Meter = x*1852"})
+	})
+
+	t.Run("Chained", func(t *testing.T) {
+		is := isser.New(t)
+
+		spec, err := readSpec(strings.NewReader(`{"package": "units", "units": [
+			{"name": "degree Fahrenheit", "symbol": "°F", "conversion": {"to": "metric.Celsius", "factor": "5/9", "offset": "-160/9"}},
+			{"name": "decidegree Fahrenheit", "symbol": "d°F", "conversion": {"to": "DegreeFahrenheit", "factor": 0.1}},
+			{"name": "nautical mile", "symbol": "nmi", "conversion": {"to": "metric.Meter", "factor": 1852}},
+			{"name": "cable", "symbol": "cb", "conversion": {"to": "metric.Meter", "factor": 185.2}}
+		]}`), "json")
+		is.NoErr(err)
+
+		is.Equal(conversions(spec.Units[0]), []string{"metric.Celsius = x*(5.0/9) - 160.0/9"})
+		is.Equal(conversions(spec.Units[1]), []string{"DegreeFahrenheit = x*0.1", "metric.Celsius = x*(1.0/18) - 160.0/9"})
+		is.Equal(conversions(spec.Units[2]), []string{"metric.Meter = x*1852", "Cable = x*10"})
+		is.Equal(conversions(spec.Units[3]), []string{"metric.Meter = x*185.2"})
+	})
+
+	formats := []struct {
+		format string
+		spec   string
+	}{
+		{format: "json", spec: `{"package": "nautical", "units": [
+			{"name": "nautical mile", "symbol": "nmi", "aliases": ["NM"],
+			 "conversion": {"to": "metric.Meter", "factor": 1852}, "conversions": [{"to": "Cable", "factor": 10}]},
+			{"name": "cable", "symbol": "cb"},
+			{"name": "degree Fahrenheit", "symbol": "°F", "conversion": {"to": "metric.Celsius", "factor": "5/9", "offset": "-160/9"}}
+		]}`},
+		{format: "yaml", spec: `
+package: nautical
+units:
+  - name: nautical mile
+    symbol: nmi
+    aliases: [NM]
+    conversion: {to: metric.Meter, factor: 1852}
+    conversions:
+      - to: Cable
+        factor: 10
+  - name: cable
+    symbol: cb
+  - name: degree Fahrenheit
+    symbol: °F
+    conversion: {to: metric.Celsius, factor: 5/9, offset: -160/9}
+`},
+		{format: "toml", spec: `
+package = "nautical"
+
+[[units]]
+name = "nautical mile"
+symbol = "nmi"
+aliases = ["NM"]
+conversion = {to = "metric.Meter", factor = 1852}
+conversions = [{to = "Cable", factor = 10}]
+
+[[units]]
+name = "cable"
+symbol = "cb"
+
+[[units]]
+name = "degree Fahrenheit"
+symbol = "°F"
+conversion = {to = "metric.Celsius", factor = "5/9", offset = "-160/9"}
+`},
+	}
+
+	for _, tt := range formats {
+		tt := tt
+		t.Run(tt.format, func(t *testing.T) {
+			is := isser.New(t)
+
+			spec, err := readSpec(strings.NewReader(tt.spec), tt.format)
+			is.NoErr(err)
+
+			is.Equal(spec.Package, "nautical")
+			is.Equal(len(spec.Units), 3)
+			is.Equal(spec.Units[0].Var, "NauticalMile")
+			is.Equal(spec.Units[0].Aliases, []string{"NM"})
+			is.Equal(conversions(spec.Units[0]), []string{"metric.Meter = x*1852", "Cable = x*10"})
+			is.Equal(conversions(spec.Units[2]), []string{"metric.Celsius = x*(5.0/9) - 160.0/9"})
+			is.Equal(spec.Units[1].Symbol, "cb")
+		})
+	}
+
+	tests := []struct {
+		name string
+		spec string
+	}{
+		{name: "Malformed", spec: `{"package": "units", "units": [`},
+		{name: "UnknownField", spec: `{"package": "units", "unit": []}`},
+		{name: "InvalidPackage", spec: `{"package": "my-units", "units": [{"name": "mile", "symbol": "mi"}]}`},
+		{name: "NoUnits", spec: `{"package": "units"}`},
+		{name: "NoSymbol", spec: `{"package": "units", "units": [{"name": "mile"}]}`},
+		{name: "UnexportedVar", spec: `{"package": "units", "units": [{"var": "mile", "name": "mile", "symbol": "mi"}]}`},
+		{name: "DuplicateVar", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi"}, {"name": "mile", "symbol": "ml"}]}`},
+		{name: "DuplicateSymbol", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi"}, {"name": "minim", "symbol": "mi"}]}`},
+		{name: "DuplicateAlias", spec: `{"package": "units", "units": [
+			{"name": "mile", "symbol": "mi", "aliases": ["m"]}, {"name": "minim", "symbol": "min", "aliases": ["m"]}]}`},
+		{name: "UnknownSystem", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi", "system": "Imperial"}]}`},
+		{name: "UnknownTerm", spec: `{"package": "units", "units": [{"name": "knot", "symbol": "kn", "terms": [{"unit": "NauticalMile", "exponent": 1}]}]}`},
+		{name: "ZeroExponent", spec: `{"package": "units", "units": [{"name": "knot", "symbol": "kn", "terms": [{"unit": "metric.Meter", "exponent": 0}]}]}`},
+		{name: "UnknownTarget", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi", "conversion": {"to": "Meter", "factor": 1609.344}}]}`},
+		{name: "InvalidFactor", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi", "conversion": {"to": "metric.Meter", "factor": "1/0"}}]}`},
+		{name: "ZeroFactor", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi", "conversion": {"to": "metric.Meter"}}]}`},
+		{name: "ConversionToItself", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi", "conversion": {"to": "Mile", "factor": 1}}]}`},
+		{name: "DuplicateConversion", spec: `{"package": "units", "units": [{"name": "mile", "symbol": "mi",
+			"conversion": {"to": "metric.Meter", "factor": 1609.344}, "conversions": [{"to": "metric.Meter", "factor": 1609}]}]}`},
+		{name: "ConversionClash", spec: `{"package": "units", "units": [
+			{"name": "mile", "symbol": "mi", "conversion": {"to": "metric.Meter", "factor": 1609.344}},
+			{"var": "MileToMeter", "name": "mile to meter", "symbol": "mi2m"}]}`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			_, err := readSpec(strings.NewReader(tt.spec), "json")
+			is.True(errors.Is(err, errInvalidSpec))
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	is := isser.New(t)
+
+	tests := []struct {
+		number   Number
+		expected string
+	}{
+		{newNumber(12, 1), "12"},
+		{newNumber(3048, 10000), "0.3048"},
+		{newNumber(1609344, 1000), "1609.344"},
+		{newNumber(1, 16), "0.0625"},
+		{newNumber(5, 9), "5.0/9"},
+		{newNumber(-160, 9), "-160.0/9"},
+	}
+
+	for _, tt := range tests {
+		is.Equal(tt.number.Go(), tt.expected)
+	}
+	is.True(newNumber(1, 2).equal(newNumber(2, 4)))
+}
+
+// conversions describes the conversions of the unit, e.g. "metric.Meter = x*1852".
+func conversions(unit UnitSpec) []string {
+	var described []string
+	for _, c := range unit.Conversions {
+		described = append(described, c.To+" = x*"+funcs["factor"].(func(Number) string)(c.Factor)+signed(c.Offset, "+", "-"))
+	}
+	return described
+}
+
+func TestReadSpecUnknownFields(t *testing.T) {
+	tests := []struct {
+		format string
+		spec   string
+	}{
+		{format: "yaml", spec: "package: units\nunit: []\n"},
+		{format: "toml", spec: "package = \"units\"\nunit = []\n"},
+		{format: "xml", spec: "<units/>"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.format, func(t *testing.T) {
+			is := isser.New(t)
+
+			_, err := readSpec(strings.NewReader(tt.spec), tt.format)
+			is.True(errors.Is(err, errInvalidSpec))
+		})
+	}
+}
+
+func TestSpecFormat(t *testing.T) {
+	is := isser.New(t)
+
+	for path, expected := range map[string]string{"units.json": "json", "units.yaml": "yaml", "units.YML": "yaml", "units.toml": "toml"} {
+		format, err := specFormat(path)
+		is.NoErr(err)
+		is.Equal(format, expected)
+	}
+
+	_, err := specFormat("units.xml")
+	is.True(errors.Is(err, errInvalidSpec))
+}
+
+func TestCamelCase(t *testing.T) {
+	is := isser.New(t)
+
+	is.Equal(camelCase("mile"), "Mile")
+	is.Equal(camelCase("mile per hour"), "MilePerHour")
+	is.Equal(camelCase("degree Fahrenheit"), "DegreeFahrenheit")
+	is.Equal(camelCase("pound-force"), "PoundForce")
+}
@@ -7,6 +7,9 @@ import (
 
 var (
 	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrLogarithmicArithmetic is returned when linear arithmetic is attempted on a logarithmic level, e.g. adding two dBm values.
+	ErrLogarithmicArithmetic = errors.New("linear arithmetic on a logarithmic level")
 )
 
 type ErrIncompatibleMetric struct {
@@ -0,0 +1,374 @@
+// Package exporter writes quantities in the Prometheus text exposition format and in OpenMetrics.
+//
+// Observations are converted to the base unit of their dimension following the Prometheus naming conventions,
+// e.g. milliseconds to seconds and kilobytes to bytes, through metric.UnitConverter. Metric names are suffixed with the
+// unit, e.g. "http_request_duration" becomes "http_request_duration_seconds", and OpenMetrics families get a "# UNIT" line.
+package exporter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/histogram"
+)
+
+var (
+	ErrInvalidName     = errors.New("invalid metric name")
+	ErrNegativeCounter = errors.New("counters cannot decrease")
+	ErrConflict        = errors.New("conflicting metric family")
+)
+
+// Type is the type of a metric family.
+type Type string
+
+const (
+	Gauge     Type = "gauge"
+	Counter   Type = "counter"
+	Histogram Type = "histogram"
+)
+
+// Label is a name and a value identifying a series of a metric family.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// baseUnit is a unit metrics are exported in, with its plural name for suffixes and its singular name for denominators.
+type baseUnit struct {
+	unit     metric.Unit
+	plural   string
+	singular string
+}
+
+// baseUnits lists the units of the Prometheus naming conventions; observations in other units of the same dimension
+// are converted to them.
+var baseUnits = []baseUnit{
+	{unit: metric.Second, plural: "seconds", singular: "second"},
+	{unit: metric.Byte, plural: "bytes", singular: "byte"},
+	{unit: metric.Meter, plural: "meters", singular: "meter"},
+	{unit: metric.Gram, plural: "grams", singular: "gram"},
+	{unit: metric.Celsius, plural: "celsius", singular: "celsius"},
+	{unit: metric.Ampere, plural: "amperes", singular: "ampere"},
+	{unit: metric.Volt, plural: "volts", singular: "volt"},
+	{unit: metric.Joule, plural: "joules", singular: "joule"},
+	{unit: metric.Watt, plural: "watts", singular: "watt"},
+	{unit: metric.One, plural: "ratio", singular: "ratio"},
+}
+
+// Base returns the unit the Quantity of the unit is exported in and the metric-name suffix of that unit:
+// a base unit of the Prometheus conventions, e.g. metric.Second and "seconds" for metric.Millisecond,
+// a quotient of base units, e.g. metric.Speed and "meters_per_second", or otherwise the unit itself with its name as suffix.
+// The terms of quotients are converted to their base units first, so that metric.BitPerSecond and
+// metric.KilobitPerSecond are exported in metric.BytePerSecond with the suffix "bytes_per_second".
+func Base(unit metric.Unit) (metric.Unit, string) {
+	if base, ok := findBase(unit); ok {
+		return base.unit, base.plural
+	}
+
+	if derived, ok := unit.(metric.DerivedUnit); ok && len(derived.Terms()) > 0 {
+		if base, suffix, ok := quotientBase(unit, derived.Terms()); ok {
+			return base, suffix
+		}
+		return unit, sanitize(unit.Name())
+	}
+
+	// Units without terms are exported like the derived units they convert to.
+	for _, system := range metric.SystemsOfUnits() {
+		for _, other := range system.Units() {
+			derived, ok := other.(metric.DerivedUnit)
+			if !ok || len(derived.Terms()) == 0 || !convertible(unit, other) {
+				continue
+			}
+			if base, suffix, ok := quotientBase(unit, derived.Terms()); ok {
+				return base, suffix
+			}
+		}
+	}
+
+	return unit, sanitize(unit.Name())
+}
+
+// quotientBase returns the quotient of the base units of terms with exponents 1 and -1, e.g. metric.BytePerSecond
+// and "bytes_per_second" for bit and second⁻¹, if the unit converts to it.
+// Terms that are base units already make the unit itself the quotient, e.g. metric.Speed and "meters_per_second".
+func quotientBase(unit metric.Unit, terms []metric.DerivedUnitTerm) (metric.Unit, string, bool) {
+	var numerator, denominator []string
+	var baseTerms []metric.DerivedUnitTerm
+	converted := false
+
+	for _, term := range terms {
+		base, ok := findBase(term.Metric())
+		if !ok {
+			return nil, "", false
+		}
+		converted = converted || base.unit != term.Metric()
+		baseTerms = append(baseTerms, metric.NewDerivedUnitTerm(base.unit, term.Exponent()))
+
+		switch term.Exponent() {
+		case 1:
+			numerator = append(numerator, base.plural)
+		case -1:
+			denominator = append(denominator, base.singular)
+		default:
+			return nil, "", false
+		}
+	}
+	if len(numerator) == 0 || len(denominator) == 0 {
+		return nil, "", false
+	}
+
+	suffix := strings.Join(numerator, "_") + "_per_" + strings.Join(denominator, "_")
+	if !converted {
+		return unit, suffix, true
+	}
+
+	base := metric.UnitOf(baseTerms...)
+	if !convertible(unit, base) {
+		return nil, "", false
+	}
+	return base, suffix, true
+}
+
+// findBase returns the base unit the Metric is, or converts to.
+func findBase(m metric.Metric) (baseUnit, bool) {
+	for _, base := range baseUnits {
+		if base.unit == m {
+			return base, true
+		}
+	}
+
+	unit, ok := m.(metric.Unit)
+	if !ok {
+		return baseUnit{}, false
+	}
+	for _, base := range baseUnits {
+		if convertible(unit, base.unit) {
+			return base, true
+		}
+	}
+	return baseUnit{}, false
+}
+
+// convertible returns true if metric.UnitConverter converts the unit to the target.
+func convertible(unit, target metric.Unit) bool {
+	_, err := metric.UnitConverter.Convert(metric.NewQuantity(1, unit), target)
+	return err == nil
+}
+
+// sanitize turns the name into a valid metric-name fragment, e.g. "degree Fahrenheit" into "degree_fahrenheit".
+func sanitize(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteByte('_')
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
+
+// family is a named set of series of one type and unit.
+type family struct {
+	name   string
+	help   string
+	typ    Type
+	unit   metric.Unit
+	suffix string
+	series map[string]*series
+}
+
+// series holds the samples of one set of labels: the value of gauges and counters, or a histogram snapshot.
+type series struct {
+	labels []Label
+	value  float64
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// Exporter collects the latest observations of metric families. It is safe for concurrent use.
+type Exporter struct {
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+// New creates an empty Exporter.
+func New() *Exporter {
+	return &Exporter{families: make(map[string]*family)}
+}
+
+// Set sets the gauge of the name and labels to the Quantity, converted to its base unit.
+func (e *Exporter) Set(name, help string, quantity metric.Quantity, labels ...Label) error {
+	return e.update(name, help, Gauge, quantity, labels, func(s *series, amount float64) error {
+		s.value = amount
+		return nil
+	})
+}
+
+// Add increases the counter of the name and labels by the non-negative Quantity, converted to its base unit.
+func (e *Exporter) Add(name, help string, quantity metric.Quantity, labels ...Label) error {
+	return e.update(name, help, Counter, quantity, labels, func(s *series, amount float64) error {
+		if amount < 0 {
+			return fmt.Errorf("%w: %s by %s", ErrNegativeCounter, name, quantity)
+		}
+		s.value += amount
+		return nil
+	})
+}
+
+func (e *Exporter) update(name, help string, typ Type, quantity metric.Quantity, labels []Label, apply func(*series, float64) error) error {
+	unit, ok := quantity.Metric().(metric.Unit)
+	if !ok {
+		return fmt.Errorf("%w: %s", metric.ErrMetricIsNotUnit, quantity.Metric())
+	}
+
+	f, err := e.family(name, help, typ, unit)
+	if err != nil {
+		return err
+	}
+	amount, err := convert(quantity.Amount(), unit, f.unit)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s, err := f.get(labels)
+	if err != nil {
+		return err
+	}
+	return apply(s, amount)
+}
+
+// Observe records a snapshot of the Histogram under the name and labels, with its bounds and sum converted to the base unit.
+func (e *Exporter) Observe(name, help string, h *histogram.Histogram, labels ...Label) error {
+	f, err := e.family(name, help, Histogram, h.Unit())
+	if err != nil {
+		return err
+	}
+
+	buckets := h.Buckets()
+	bounds := make([]float64, len(buckets))
+	counts := make([]uint64, len(buckets))
+	var count uint64
+	for i, bucket := range buckets {
+		if bounds[i], err = convert(bucket.UpperBound.Amount(), h.Unit(), f.unit); err != nil {
+			return err
+		}
+		count += bucket.Count
+		counts[i] = count
+	}
+
+	// The sum is converted through the mean so that affine units, such as the kelvin to the degree Celsius, keep their offset once.
+	var sum float64
+	if count > 0 {
+		mean, err := convert(h.Sum().Amount()/float64(count), h.Unit(), f.unit)
+		if err != nil {
+			return err
+		}
+		sum = mean * float64(count)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s, err := f.get(labels)
+	if err != nil {
+		return err
+	}
+	s.bounds, s.counts, s.count, s.sum = bounds, counts, count, sum
+
+	return nil
+}
+
+func convert(amount float64, from, to metric.Unit) (float64, error) {
+	if from == to {
+		return amount, nil
+	}
+
+	converted, err := metric.UnitConverter.Convert(metric.NewQuantity(amount, from), to)
+	if err != nil {
+		return 0, fmt.Errorf("converting %s to %s: %w", from, to, err)
+	}
+	return converted.Amount(), nil
+}
+
+// family returns the family of the name, suffixed with the unit, creating it on first use.
+func (e *Exporter) family(name, help string, typ Type, unit metric.Unit) (*family, error) {
+	base, suffix := Base(unit)
+
+	name = strings.TrimSuffix(name, "_total")
+	if !validName(name, true) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	if !strings.HasSuffix(name, "_"+suffix) {
+		name += "_" + suffix
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	f, ok := e.families[name]
+	if !ok {
+		f = &family{name: name, help: help, typ: typ, unit: base, suffix: suffix, series: make(map[string]*series)}
+		e.families[name] = f
+		return f, nil
+	}
+	if f.typ != typ || f.unit != base {
+		return nil, fmt.Errorf("%w: %s is a %s in %s, not a %s in %s", ErrConflict, name, f.typ, f.unit, typ, base)
+	}
+	if help != "" {
+		f.help = help
+	}
+
+	return f, nil
+}
+
+// get returns the series of the labels, creating it on first use.
+func (f *family) get(labels []Label) (*series, error) {
+	sorted := append([]Label(nil), labels...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var key strings.Builder
+	for i, label := range sorted {
+		if !validName(label.Name, false) || label.Name == "le" && f.typ == Histogram {
+			return nil, fmt.Errorf("%w: label %q", ErrInvalidName, label.Name)
+		}
+		if i > 0 && label.Name == sorted[i-1].Name {
+			return nil, fmt.Errorf("%w: duplicate label %q", ErrInvalidName, label.Name)
+		}
+		key.WriteString(label.Name + `="` + escape(label.Value, true) + `",`)
+	}
+
+	s, ok := f.series[key.String()]
+	if !ok {
+		s = &series{labels: sorted}
+		f.series[key.String()] = s
+	}
+	return s, nil
+}
+
+// validName reports whether the name is a valid metric name, with colons, or label name, without them.
+func validName(name string, colons bool) bool {
+	if name == "" || strings.HasPrefix(name, "__") && !colons {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case r == ':' && colons:
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package exporter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/exporter"
+	isser "github.com/matryer/is"
+)
+
+func TestBase(t *testing.T) {
+	tests := []struct {
+		name   string
+		unit   metric.Unit
+		base   metric.Unit
+		suffix string
+	}{
+		{name: "Second", unit: metric.Second, base: metric.Second, suffix: "seconds"},
+		{name: "Millisecond", unit: metric.Millisecond, base: metric.Second, suffix: "seconds"},
+		{name: "Kilobyte", unit: metric.Kilobyte, base: metric.Byte, suffix: "bytes"},
+		{name: "Bit", unit: metric.Bit, base: metric.Byte, suffix: "bytes"},
+		{name: "Kelvin", unit: metric.Kelvin, base: metric.Celsius, suffix: "celsius"},
+		{name: "Percent", unit: metric.Percent, base: metric.One, suffix: "ratio"},
+		{name: "Gram", unit: metric.Gram, base: metric.Gram, suffix: "grams"},
+		{name: "Kilogram", unit: metric.Kilogram, base: metric.Gram, suffix: "grams"},
+		{name: "Speed", unit: metric.Speed, base: metric.Speed, suffix: "meters_per_second"},
+		{name: "BytePerSecond", unit: metric.BytePerSecond, base: metric.BytePerSecond, suffix: "bytes_per_second"},
+		{name: "BitPerSecond", unit: metric.BitPerSecond, base: metric.BytePerSecond, suffix: "bytes_per_second"},
+		{name: "KilobitPerSecond", unit: metric.KilobitPerSecond, base: metric.BytePerSecond, suffix: "bytes_per_second"},
+		{name: "Lumen", unit: metric.Lumen, base: metric.Lumen, suffix: "lumen"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			base, suffix := exporter.Base(tt.unit)
+			is.Equal(base, tt.base)
+			is.Equal(suffix, tt.suffix)
+		})
+	}
+}
+
+func TestConvertedTerms(t *testing.T) {
+	is := isser.New(t)
+
+	e := exporter.New()
+	is.NoErr(e.Set("link_rate", "", metric.NewQuantity(8, metric.KilobitPerSecond)))
+	is.NoErr(e.Set("payload", "", metric.NewQuantity(1.5, metric.Kilogram)))
+
+	var b strings.Builder
+	is.NoErr(e.WriteText(&b))
+	is.True(strings.Contains(b.String(), "link_rate_bytes_per_second 1000\n"))
+	is.True(strings.Contains(b.String(), "payload_grams 1500\n"))
+}
+
+func TestErrors(t *testing.T) {
+	is := isser.New(t)
+
+	e := exporter.New()
+
+	err := e.Set("1requests", "", metric.NewQuantity(1, metric.Second))
+	is.True(errors.Is(err, exporter.ErrInvalidName))
+
+	err = e.Set("latency", "", metric.NewQuantity(1, metric.Second), exporter.Label{Name: "a-b", Value: "x"})
+	is.True(errors.Is(err, exporter.ErrInvalidName))
+
+	err = e.Set("latency", "", metric.NewQuantity(1, metric.Second), exporter.Label{Name: "a", Value: "x"}, exporter.Label{Name: "a", Value: "y"})
+	is.True(errors.Is(err, exporter.ErrInvalidName))
+
+	err = e.Add("sent", "", metric.NewQuantity(-1, metric.Byte))
+	is.True(errors.Is(err, exporter.ErrNegativeCounter))
+
+	is.NoErr(e.Set("latency", "", metric.NewQuantity(1, metric.Second)))
+	err = e.Add("latency", "", metric.NewQuantity(1, metric.Second))
+	is.True(errors.Is(err, exporter.ErrConflict))
+}
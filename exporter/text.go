@@ -0,0 +1,117 @@
+package exporter
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes the families in the Prometheus text exposition format, version 0.0.4.
+// Counters are named with the "_total" suffix, as Prometheus client libraries do.
+func (e *Exporter) WriteText(w io.Writer) error {
+	return e.write(w, false)
+}
+
+// WriteOpenMetrics writes the families in the OpenMetrics text format, with "# UNIT" lines and the final "# EOF".
+func (e *Exporter) WriteOpenMetrics(w io.Writer) error {
+	return e.write(w, true)
+}
+
+func (e *Exporter) write(w io.Writer, openMetrics bool) error {
+	e.mu.Lock()
+	names := make([]string, 0, len(e.families))
+	for name := range e.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		e.families[name].write(&b, openMetrics)
+	}
+	e.mu.Unlock()
+
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (f *family) write(b *strings.Builder, openMetrics bool) {
+	name := f.name
+	if f.typ == Counter && !openMetrics {
+		name += "_total"
+	}
+
+	b.WriteString("# TYPE " + name + " " + string(f.typ) + "\n")
+	if openMetrics {
+		b.WriteString("# UNIT " + name + " " + f.suffix + "\n")
+	}
+	if f.help != "" {
+		b.WriteString("# HELP " + name + " " + escape(f.help, openMetrics) + "\n")
+	}
+
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := f.series[key]
+		switch f.typ {
+		case Counter:
+			writeSample(b, f.name+"_total", s.labels, s.value)
+		case Histogram:
+			for i, bound := range s.bounds {
+				le := append(append([]Label(nil), s.labels...), Label{Name: "le", Value: formatFloat(bound)})
+				writeSample(b, f.name+"_bucket", le, float64(s.counts[i]))
+			}
+			writeSample(b, f.name+"_sum", s.labels, s.sum)
+			writeSample(b, f.name+"_count", s.labels, float64(s.count))
+		default:
+			writeSample(b, f.name, s.labels, s.value)
+		}
+	}
+}
+
+func writeSample(b *strings.Builder, name string, labels []Label, value float64) {
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(label.Name + `="` + escape(label.Value, true) + `"`)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteString(" " + formatFloat(value) + "\n")
+}
+
+// escape escapes backslashes and line feeds, and double quotes if quotes is set, as in label values.
+func escape(s string, quotes bool) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	if quotes {
+		s = strings.ReplaceAll(s, `"`, `\"`)
+	}
+	return s
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
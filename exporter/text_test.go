@@ -0,0 +1,84 @@
+package exporter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/exporter"
+	"github.com/IAmRadek/metric/histogram"
+	isser "github.com/matryer/is"
+)
+
+func populated(t *testing.T) *exporter.Exporter {
+	t.Helper()
+
+	bounds, err := histogram.ExponentialBuckets(metric.NewQuantity(100, metric.Millisecond), 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := histogram.New(metric.Millisecond, bounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, amount := range []float64{50, 250, 4000} {
+		if err := h.Observe(metric.NewQuantity(amount, metric.Millisecond)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e := exporter.New()
+	for _, err := range []error{
+		e.Set("room_temperature", "Temperature of the room.", metric.NewQuantity(294.15, metric.Kelvin), exporter.Label{Name: "room", Value: `kitchen "north"`}),
+		e.Add("http_response_size_total", "Bytes sent.\nIncluding headers.", metric.NewQuantity(2, metric.Kilobyte), exporter.Label{Name: "code", Value: "200"}),
+		e.Add("http_response_size", "", metric.NewQuantity(512, metric.Byte), exporter.Label{Name: "code", Value: "200"}),
+		e.Observe("http_request_duration", "Request latency.", h, exporter.Label{Name: "method", Value: "GET"}),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e
+}
+
+func TestWriteText(t *testing.T) {
+	is := isser.New(t)
+
+	var b strings.Builder
+	is.NoErr(populated(t).WriteText(&b))
+	is.Equal(b.String(), `# TYPE http_request_duration_seconds histogram
+# HELP http_request_duration_seconds Request latency.
+http_request_duration_seconds_bucket{method="GET",le="0.1"} 1
+http_request_duration_seconds_bucket{method="GET",le="1"} 2
+http_request_duration_seconds_bucket{method="GET",le="+Inf"} 3
+http_request_duration_seconds_sum{method="GET"} 4.3
+http_request_duration_seconds_count{method="GET"} 3
+# TYPE http_response_size_bytes_total counter
+# HELP http_response_size_bytes_total Bytes sent.\nIncluding headers.
+http_response_size_bytes_total{code="200"} 2512
+# TYPE room_temperature_celsius gauge
+# HELP room_temperature_celsius Temperature of the room.
+room_temperature_celsius{room="kitchen \"north\""} 21
+`)
+}
+
+func TestWriteOpenMetrics(t *testing.T) {
+	is := isser.New(t)
+
+	e := exporter.New()
+	is.NoErr(e.Add("http_response_size", "Bytes sent.", metric.NewQuantity(8, metric.Bit)))
+	is.NoErr(e.Set("disk_usage", `Used "disk" space.`, metric.NewQuantity(25, metric.Percent)))
+
+	var b strings.Builder
+	is.NoErr(e.WriteOpenMetrics(&b))
+	is.Equal(b.String(), `# TYPE disk_usage_ratio gauge
+# UNIT disk_usage_ratio ratio
+# HELP disk_usage_ratio Used \"disk\" space.
+disk_usage_ratio 0.25
+# TYPE http_response_size_bytes counter
+# UNIT http_response_size_bytes bytes
+# HELP http_response_size_bytes Bytes sent.
+http_response_size_bytes_total 1
+# EOF
+`)
+}
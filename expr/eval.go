@@ -0,0 +1,250 @@
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/IAmRadek/metric"
+)
+
+type evaluator struct {
+	source    string
+	variables Variables
+}
+
+func (ev *evaluator) errorAt(n node, err error) error {
+	var exprErr *Error
+	if errors.As(err, &exprErr) {
+		return err
+	}
+
+	s := n.position()
+	return &Error{Expression: ev.source, Start: s.start, End: s.end, Err: err}
+}
+
+func (ev *evaluator) eval(n node) (metric.Quantity, error) {
+	switch n := n.(type) {
+	case *numberNode:
+		return metric.NewQuantity(n.value, metric.One), nil
+
+	case *identifierNode:
+		if quantity, ok := ev.variables[n.name]; ok {
+			return quantity, nil
+		}
+		unit, err := metric.LookupUnit(n.name)
+		if err != nil {
+			return nil, ev.errorAt(n, fmt.Errorf("%w: %q", ErrUnknownIdentifier, n.name))
+		}
+		return metric.NewQuantity(1, unit), nil
+
+	case *literalNode:
+		unit, err := metric.LookupUnit(n.unit.name)
+		if err != nil {
+			return nil, ev.errorAt(&n.unit, err)
+		}
+		if n.unit.exponent == 1 {
+			return metric.NewQuantity(n.value, unit), nil
+		}
+		raised, err := power(metric.NewQuantity(1, unit), n.unit.exponent)
+		if err != nil {
+			return nil, ev.errorAt(&n.unit, err)
+		}
+		return metric.NewQuantity(n.value, raised.Metric()), nil
+
+	case *unaryNode:
+		operand, err := ev.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		if n.operator == "+" {
+			return operand, nil
+		}
+		negated, err := operand.Multiply(-1)
+		if err != nil {
+			return nil, ev.errorAt(n, err)
+		}
+		return negated, nil
+
+	case *binaryNode:
+		left, err := ev.eval(n.left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := ev.eval(n.right)
+		if err != nil {
+			return nil, err
+		}
+		result, err := binary(n.operator, left, right)
+		if err != nil {
+			return nil, ev.errorAt(n, err)
+		}
+		return result, nil
+
+	case *powerNode:
+		base, err := ev.eval(n.base)
+		if err != nil {
+			return nil, err
+		}
+		result, err := power(base, n.exponent)
+		if err != nil {
+			return nil, ev.errorAt(n, err)
+		}
+		return result, nil
+
+	case *callNode:
+		function, ok := functions[n.name]
+		if !ok {
+			return nil, ev.errorAt(n, fmt.Errorf("%w: %q", ErrUnknownFunction, n.name))
+		}
+
+		arguments := make([]metric.Quantity, len(n.arguments))
+		for i, argument := range n.arguments {
+			value, err := ev.eval(argument)
+			if err != nil {
+				return nil, err
+			}
+			arguments[i] = value
+		}
+
+		result, err := function(arguments)
+		if err != nil {
+			return nil, ev.errorAt(n, fmt.Errorf("%s: %w", n.name, err))
+		}
+		return result, nil
+	}
+
+	return nil, ev.errorAt(n, fmt.Errorf("%w: unsupported expression", ErrSyntax))
+}
+
+// binary applies the operator. Sums and differences are in the unit of the left operand, e.g. 1 km + 1 m is 1.001 km.
+// Products and quotients with a plain number scale the other operand;
+// those of two quantities derive a unit with Quantity.MultiplyBy and Quantity.DivideBy, which is then simplified.
+func binary(operator string, left, right metric.Quantity) (metric.Quantity, error) {
+	switch operator {
+	case "+":
+		return left.Add(inUnitOf(right, left))
+	case "-":
+		return left.Subtract(inUnitOf(right, left))
+	case "*":
+		switch {
+		case right.Metric() == metric.One:
+			return left.Multiply(right.Amount())
+		case left.Metric() == metric.One:
+			return right.Multiply(left.Amount())
+		}
+		product, err := left.MultiplyBy(right)
+		if err != nil {
+			return nil, err
+		}
+		return simplify(product), nil
+	case "/":
+		if right.Metric() == metric.One {
+			return left.Divide(right.Amount())
+		}
+		if right.Amount() == 0 {
+			return nil, metric.ErrDivisionByZero
+		}
+		quotient, err := left.DivideBy(right)
+		if err != nil {
+			return nil, err
+		}
+		return simplify(quotient), nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown operator %q", ErrSyntax, operator)
+}
+
+// power raises the quantity to the integer exponent, e.g. 2 m to 4 m².
+func power(base metric.Quantity, exponent int) (metric.Quantity, error) {
+	amount := math.Pow(base.Amount(), float64(exponent))
+	if base.Metric() == metric.One {
+		return metric.NewQuantity(amount, metric.One), nil
+	}
+	if metric.IsLevel(base.Metric()) {
+		return nil, fmt.Errorf("%w: cannot raise %s to a power", metric.ErrLogarithmicArithmetic, base)
+	}
+
+	return metric.NewQuantity(amount, metric.UnitOf(metric.ExpandTerms(base.Metric(), exponent)...)), nil
+}
+
+// functions lists the functions available in expressions.
+var functions = map[string]func([]metric.Quantity) (metric.Quantity, error){
+	"sqrt": sqrt,
+	"abs": func(arguments []metric.Quantity) (metric.Quantity, error) {
+		if len(arguments) != 1 {
+			return nil, fmt.Errorf("%w: expected 1 argument, got %d", ErrArguments, len(arguments))
+		}
+		return metric.NewQuantity(math.Abs(arguments[0].Amount()), arguments[0].Metric()), nil
+	},
+	"min": func(arguments []metric.Quantity) (metric.Quantity, error) {
+		return extreme(arguments, func(q1, q2 metric.Quantity) (bool, error) { return q1.LessThan(q2) })
+	},
+	"max": func(arguments []metric.Quantity) (metric.Quantity, error) {
+		return extreme(arguments, func(q1, q2 metric.Quantity) (bool, error) { return q1.GreaterThan(q2) })
+	},
+}
+
+// sqrt returns the square root of a quantity whose unit has even exponents, e.g. 3 m for 9 m².
+func sqrt(arguments []metric.Quantity) (metric.Quantity, error) {
+	if len(arguments) != 1 {
+		return nil, fmt.Errorf("%w: expected 1 argument, got %d", ErrArguments, len(arguments))
+	}
+
+	q := arguments[0]
+	if q.Amount() < 0 {
+		return nil, fmt.Errorf("%w: negative amount %s", ErrArguments, q)
+	}
+
+	halved := metric.ExpandTerms(q.Metric(), 1)
+	for i, term := range halved {
+		if term.Exponent()%2 != 0 {
+			return nil, fmt.Errorf("%w: %s is not a square", ErrDimension, q.Metric())
+		}
+		halved[i] = metric.NewDerivedUnitTerm(term.Metric(), term.Exponent()/2)
+	}
+
+	return metric.NewQuantity(math.Sqrt(q.Amount()), metric.UnitOf(halved...)), nil
+}
+
+// extreme returns the argument for which better returns true against every other argument,
+// in the unit of the first argument, e.g. 2000 m for max(1 m, 2 km).
+func extreme(arguments []metric.Quantity, better func(q1, q2 metric.Quantity) (bool, error)) (metric.Quantity, error) {
+	if len(arguments) == 0 {
+		return nil, fmt.Errorf("%w: expected at least 1 argument", ErrArguments)
+	}
+
+	result := arguments[0]
+	for _, argument := range arguments[1:] {
+		argument = inUnitOf(argument, result)
+		ok, err := better(argument, result)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = argument
+		}
+	}
+
+	return result, nil
+}
+
+// inUnitOf converts the quantity to the unit of the target with metric.UnitConverter.
+// Quantities that cannot be converted are returned as they are, for the arithmetic to report the incompatible metrics.
+func inUnitOf(quantity, target metric.Quantity) metric.Quantity {
+	unit, ok := target.Metric().(metric.Unit)
+	if !ok || quantity.Metric() == target.Metric() {
+		return quantity
+	}
+
+	converted, err := metric.UnitConverter.Convert(quantity, unit)
+	if err != nil {
+		return quantity
+	}
+	return converted
+}
+
+// simplify replaces the unit of a product or quotient with a unit of the combined terms, e.g. m*m with metric.Area.
+func simplify(quantity metric.Quantity) metric.Quantity {
+	return metric.NewQuantity(quantity.Amount(), metric.UnitOf(metric.ExpandTerms(quantity.Metric(), 1)...))
+}
@@ -0,0 +1,202 @@
+package expr_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/expr"
+	isser "github.com/matryer/is"
+)
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		variables  expr.Variables
+		amount     float64
+		symbol     string
+		unit       metric.Metric
+	}{
+		{name: "Scaled", expression: "120 km/h * 0.9", amount: 108, symbol: "km/h"},
+		{name: "Rate", expression: "(5 GB) / (30 min)", amount: 1. / 6, symbol: "GB/min"},
+		{name: "QuantityLiteralBindsTightest", expression: "5 GB / 30 min", amount: 1. / 6, symbol: "GB/min"},
+		{name: "Precedence", expression: "1 m + 2 m * 3", amount: 7, unit: metric.Meter},
+		{name: "Parentheses", expression: "(1 m + 2 m) * 3", amount: 9, unit: metric.Meter},
+		{name: "LeftAssociative", expression: "8 m / 2 / 2", amount: 2, unit: metric.Meter},
+		{name: "Negation", expression: "-(2 m - 5 m)", amount: 3, unit: metric.Meter},
+		{name: "RegisteredProduct", expression: "3 m * 4 m", amount: 12, unit: metric.Area},
+		{name: "RegisteredQuotient", expression: "100 m / 10 s", amount: 10, unit: metric.Speed},
+		{name: "Cancelled", expression: "10 m / 2 m", amount: 5, unit: metric.One},
+		{name: "ExpandedRegisteredUnit", expression: "10 m/s * 2 s", amount: 20, unit: metric.Meter},
+		{name: "UnitPower", expression: "2 m^3", amount: 2, unit: metric.Volume},
+		{name: "QuantityPower", expression: "(2 m)^2", amount: 4, unit: metric.Area},
+		{name: "LiteralPower", expression: "2 m ^ 2", amount: 4, unit: metric.Area},
+		{name: "LevelLiteral", expression: "20 dBm", amount: 20, unit: metric.DecibelMilliwatt},
+		{name: "NumberPower", expression: "2^-2", amount: 0.25, unit: metric.One},
+		{name: "Variable", expression: "max_speed * 0.5", variables: expr.Variables{"max_speed": metric.NewQuantity(120, metric.Speed)}, amount: 60, unit: metric.Speed},
+		{name: "VariableShadowsUnit", expression: "m * 2", variables: expr.Variables{"m": metric.NewQuantity(3, metric.Second)}, amount: 6, unit: metric.Second},
+		{name: "UnitAfterNumber", expression: "2 m", variables: expr.Variables{"m": metric.NewQuantity(3, metric.Second)}, amount: 2, unit: metric.Meter},
+		{name: "BareUnit", expression: "h", amount: 1, unit: metric.Hour},
+		{name: "Sqrt", expression: "sqrt(9 m * 1 m)", amount: 3, unit: metric.Meter},
+		{name: "SqrtNumber", expression: "sqrt(16)", amount: 4, unit: metric.One},
+		{name: "Abs", expression: "abs(-3 s)", amount: 3, unit: metric.Second},
+		{name: "Min", expression: "min(3 m, 2 m, 5 m)", amount: 2, unit: metric.Meter},
+		{name: "Max", expression: "max(3 m, 2 m, 5 m)", amount: 5, unit: metric.Meter},
+		{name: "MinIsNotMinute", expression: "min(30 min, 1 min)", amount: 1, unit: metric.Minute},
+		{name: "SumInLeftUnit", expression: "1 km + 500 m", amount: 1.5, symbol: "km"},
+		{name: "DifferenceInLeftUnit", expression: "1 h - 30 min", amount: 0.5, unit: metric.Hour},
+		{name: "MaxInFirstUnit", expression: "max(1 m, 2 km)", amount: 2000, unit: metric.Meter},
+		{name: "MinInFirstUnit", expression: "min(1 km, 20 m)", amount: 0.02, symbol: "km"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			result, err := expr.Evaluate(tt.expression, tt.variables)
+			is.NoErr(err)
+			is.True(math.Abs(result.Amount()-tt.amount) < 1e-12)
+			if tt.unit != nil {
+				is.Equal(result.Metric(), tt.unit)
+			}
+			if tt.symbol != "" {
+				is.Equal(result.Metric().Symbol(), tt.symbol)
+			}
+		})
+	}
+}
+
+func TestEvaluateDerivedUnitsAreShared(t *testing.T) {
+	is := isser.New(t)
+
+	e := expr.MustParse("5 GB / 30 min")
+	q1, err := e.Evaluate(nil)
+	is.NoErr(err)
+	q2, err := e.Evaluate(nil)
+	is.NoErr(err)
+	is.Equal(q1.Metric(), q2.Metric())
+
+	sum, err := expr.Evaluate("5 GB / 30 min + 1 GB/min", nil)
+	is.NoErr(err)
+	is.Equal(sum.Metric(), q1.Metric())
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		variables  expr.Variables
+		offending  string
+		check      func(is *isser.I, err error)
+	}{
+		{
+			name:       "IncompatibleMetric",
+			expression: "2 * (1 m + 2 s)",
+			offending:  "(1 m + 2 s)",
+			check: func(is *isser.I, err error) {
+				var incompatible metric.ErrIncompatibleMetric
+				is.True(errors.As(err, &incompatible))
+			},
+		},
+		{
+			name:       "UnknownIdentifier",
+			expression: "1 m + velocity",
+			offending:  "velocity",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, expr.ErrUnknownIdentifier))
+			},
+		},
+		{
+			name:       "UnknownUnit",
+			expression: "3 furlong",
+			offending:  "furlong",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, metric.ErrUnknownUnit))
+			},
+		},
+		{
+			name:       "UnknownFunction",
+			expression: "1 + log(2)",
+			offending:  "log(2)",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, expr.ErrUnknownFunction))
+			},
+		},
+		{
+			name:       "Arguments",
+			expression: "abs(1 m, 2 m)",
+			offending:  "abs(1 m, 2 m)",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, expr.ErrArguments))
+			},
+		},
+		{
+			name:       "NotASquare",
+			expression: "sqrt(2 m^3)",
+			offending:  "sqrt(2 m^3)",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, expr.ErrDimension))
+			},
+		},
+		{
+			name:       "IncompatibleMin",
+			expression: "min(1 m, 1 s)",
+			offending:  "min(1 m, 1 s)",
+			check: func(is *isser.I, err error) {
+				var incompatible metric.ErrIncompatibleMetric
+				is.True(errors.As(err, &incompatible))
+			},
+		},
+		{
+			name:       "DivisionByZero",
+			expression: "1 m / (0 s)",
+			offending:  "1 m / (0 s)",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, metric.ErrDivisionByZero))
+			},
+		},
+		{
+			name:       "LogarithmicLiterals",
+			expression: "20 dBm + 1 dBm",
+			offending:  "20 dBm + 1 dBm",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+			},
+		},
+		{
+			name:       "LogarithmicUnitPower",
+			expression: "20 dBm^2",
+			offending:  "dBm^2",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+			},
+		},
+		{
+			name:       "Logarithmic",
+			expression: "x * 2",
+			variables:  expr.Variables{"x": metric.NewQuantity(3, metric.DecibelMilliwatt)},
+			offending:  "x * 2",
+			check: func(is *isser.I, err error) {
+				is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			_, err := expr.Evaluate(tt.expression, tt.variables)
+			is.True(err != nil)
+			tt.check(is, err)
+
+			var exprErr *expr.Error
+			is.True(errors.As(err, &exprErr))
+			is.Equal(tt.expression[exprErr.Start:exprErr.End], tt.offending)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Package expr parses and evaluates arithmetic expressions over quantities, e.g. "120 km/h * 0.9" or "(5 GB) / (30 min)".
+//
+// Expressions combine numbers, unit literals, variables and the functions sqrt, abs, min and max with the operators
+// +, -, *, / and ^ under the usual precedence. A number followed by a unit is a quantity literal, e.g. "30 min" or "20 dBm",
+// and binds tighter than any operator, so "5 GB / 30 min" divides by thirty minutes and "2 m ^ 2" is 4 m².
+// An exponent written directly after the unit, without spaces, belongs to the unit, so "2 m^3" is 2 m³.
+//
+// Dimensions are checked with the semantics of metric.Quantity: addition, subtraction and comparison convert
+// the right operand to the unit of the left one with metric.UnitConverter, e.g. "1 km + 500 m" is 1.5 km,
+// and fail for other dimensions, while products and quotients derive new units. Derived units are simplified, so that "3 m * 4 m"
+// is 12 m² in metric.Area and "10 m / 2 m" is the dimensionless 5.
+package expr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ErrSyntax            = errors.New("syntax error")
+	ErrUnknownIdentifier = errors.New("unknown variable or unit")
+	ErrUnknownFunction   = errors.New("unknown function")
+	ErrArguments         = errors.New("invalid arguments")
+	ErrDimension         = errors.New("invalid dimension")
+)
+
+// Error describes an error in the sub-expression between the byte offsets Start and End of the Expression.
+// It wraps one of the errors of this package or an error of a metric.Quantity operation, e.g. metric.ErrIncompatibleMetric.
+type Error struct {
+	Expression string
+	Start, End int
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v in %q at offset %d of %q", e.Err, e.Expression[e.Start:e.End], e.Start, e.Expression)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Variables binds names to quantities. Variables take precedence over units of the same name,
+// except after a number, where a name is always a unit, e.g. "2 m".
+type Variables map[string]metric.Quantity
+
+// Expression is a parsed expression that can be evaluated repeatedly with different variables.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Parse parses the expression, returning an *Error on invalid syntax.
+func Parse(expression string) (*Expression, error) {
+	p := &parser{source: expression}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEnd {
+		return nil, p.errorAt(tok, fmt.Errorf("%w: unexpected %q", ErrSyntax, tok.text))
+	}
+
+	return &Expression{source: expression, root: root}, nil
+}
+
+// MustParse is like Parse but panics if the expression cannot be parsed.
+func MustParse(expression string) *Expression {
+	e, err := Parse(expression)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Evaluate evaluates the expression with the variables, which may be nil.
+// Errors are returned as an *Error pointing at the offending sub-expression.
+func (e *Expression) Evaluate(variables Variables) (metric.Quantity, error) {
+	ev := &evaluator{source: e.source, variables: variables}
+	return ev.eval(e.root)
+}
+
+// String returns the source of the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate parses and evaluates the expression with the variables, which may be nil.
+func Evaluate(expression string, variables Variables) (metric.Quantity, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(variables)
+}
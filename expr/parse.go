@@ -0,0 +1,390 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenIdentifier
+	tokenOperator
+)
+
+type token struct {
+	kind       tokenKind
+	text       string
+	start, end int
+}
+
+// span is the position of a node in the source, as byte offsets.
+type span struct {
+	start, end int
+}
+
+func (s span) position() span {
+	return s
+}
+
+func (s *span) setPosition(position span) {
+	*s = position
+}
+
+type node interface {
+	position() span
+	setPosition(span)
+}
+
+type (
+	numberNode struct {
+		span
+		value float64
+	}
+
+	// identifierNode is a variable or, if there is no such variable, a unit.
+	identifierNode struct {
+		span
+		name string
+	}
+
+	// literalNode is a number followed by a unit, e.g. "2 m" or "2 m^3".
+	literalNode struct {
+		span
+		value float64
+		unit  literalUnit
+	}
+
+	unaryNode struct {
+		span
+		operator string
+		operand  node
+	}
+
+	binaryNode struct {
+		span
+		operator    string
+		left, right node
+	}
+
+	powerNode struct {
+		span
+		base     node
+		exponent int
+	}
+
+	callNode struct {
+		span
+		name      string
+		arguments []node
+	}
+)
+
+// literalUnit is the unit of a literal with the exponent written directly after it, e.g. the "m^3" of "2 m^3".
+type literalUnit struct {
+	span
+	name     string
+	exponent int
+}
+
+type parser struct {
+	source string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) errorAt(tok token, err error) error {
+	return &Error{Expression: p.source, Start: tok.start, End: tok.end, Err: err}
+}
+
+// tokenize splits the source into numbers, identifiers and the operators + - * / ^ ( and ,.
+func (p *parser) tokenize() error {
+	for i := 0; i < len(p.source); {
+		r, size := utf8.DecodeRuneInString(p.source[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r >= '0' && r <= '9' || r == '.':
+			end := i + numberLength(p.source[i:])
+			p.tokens = append(p.tokens, token{tokenNumber, p.source[i:end], i, end})
+			i = end
+		case strings.ContainsRune("+-*/^(),", r):
+			p.tokens = append(p.tokens, token{tokenOperator, string(r), i, i + 1})
+			i++
+		case isIdentifierStart(r):
+			end := i + size
+			for end < len(p.source) {
+				r, size := utf8.DecodeRuneInString(p.source[end:])
+				if !isIdentifierStart(r) && !unicode.IsDigit(r) {
+					break
+				}
+				end += size
+			}
+			p.tokens = append(p.tokens, token{tokenIdentifier, p.source[i:end], i, end})
+			i = end
+		default:
+			return &Error{Expression: p.source, Start: i, End: i + size, Err: fmt.Errorf("%w: unexpected %q", ErrSyntax, r)}
+		}
+	}
+
+	p.tokens = append(p.tokens, token{tokenEnd, "end of expression", len(p.source), len(p.source)})
+	return nil
+}
+
+// isIdentifierStart returns true for the characters of names of variables and symbols of units, e.g. "°C", "µs" or "m²".
+func isIdentifierStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || strings.ContainsRune("°%‰′″⁻⁰¹²³⁴⁵⁶⁷⁸⁹", r)
+}
+
+// numberLength returns the length of the decimal number at the start of s, with an optional exponent.
+// An "e" starts an exponent only if digits follow, so that "5em" is not mistaken for a number.
+func numberLength(s string) int {
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+
+	return i
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEnd {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) isOperator(operators ...string) bool {
+	tok := p.peek()
+	if tok.kind != tokenOperator {
+		return false
+	}
+	for _, operator := range operators {
+		if tok.text == operator {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expect(operator string) (token, error) {
+	if !p.isOperator(operator) {
+		tok := p.peek()
+		return tok, p.errorAt(tok, fmt.Errorf("%w: expected %q, found %q", ErrSyntax, operator, tok.text))
+	}
+	return p.next(), nil
+}
+
+// parseExpression parses sums and differences of terms.
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("+", "-") {
+		operator := p.next().text
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{span{left.position().start, right.position().end}, operator, left, right}
+	}
+
+	return left, nil
+}
+
+// parseTerm parses products and quotients of factors.
+func (p *parser) parseTerm() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isOperator("*", "/") {
+		operator := p.next().text
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{span{left.position().start, right.position().end}, operator, left, right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses a factor with optional signs.
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("+", "-") {
+		tok := p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{span{tok.start, operand.position().end}, tok.text, operand}, nil
+	}
+
+	return p.parsePower()
+}
+
+// parsePower parses a primary expression raised to an optional integer power, e.g. "(2 m)^2".
+func (p *parser) parsePower() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parseExponent(base)
+}
+
+func (p *parser) parseExponent(base node) (node, error) {
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	p.next()
+
+	exponent, end, err := p.parseInteger()
+	if err != nil {
+		return nil, err
+	}
+
+	return &powerNode{span{base.position().start, end}, base, exponent}, nil
+}
+
+// parseInteger parses an integer exponent with an optional minus sign, returning it with its end offset.
+func (p *parser) parseInteger() (int, int, error) {
+	sign := 1
+	if p.isOperator("-") {
+		p.next()
+		sign = -1
+	}
+
+	tok := p.next()
+	exponent, err := strconv.Atoi(tok.text)
+	if tok.kind != tokenNumber || err != nil {
+		return 0, 0, p.errorAt(tok, fmt.Errorf("%w: the exponent must be an integer, found %q", ErrSyntax, tok.text))
+	}
+
+	return sign * exponent, tok.end, nil
+}
+
+// parsePrimary parses a number with an optional unit, a variable or unit, a function call or a parenthesized expression.
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+
+	switch tok.kind {
+	case tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorAt(tok, fmt.Errorf("%w: invalid number %q", ErrSyntax, tok.text))
+		}
+
+		if p.peek().kind == tokenIdentifier && !p.isCall() {
+			unit, err := p.parseLiteralUnit()
+			if err != nil {
+				return nil, err
+			}
+			return &literalNode{span{tok.start, unit.end}, value, unit}, nil
+		}
+
+		return &numberNode{span{tok.start, tok.end}, value}, nil
+
+	case tokenIdentifier:
+		if p.isOperator("(") {
+			return p.parseCall(tok)
+		}
+		return &identifierNode{span{tok.start, tok.end}, tok.text}, nil
+
+	case tokenOperator:
+		if tok.text == "(" {
+			inner, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			closing, err := p.expect(")")
+			if err != nil {
+				return nil, err
+			}
+			// The parentheses belong to the sub-expression reported in errors.
+			inner.setPosition(span{tok.start, closing.end})
+			return inner, nil
+		}
+	}
+
+	return nil, p.errorAt(tok, fmt.Errorf("%w: unexpected %q", ErrSyntax, tok.text))
+}
+
+// parseLiteralUnit parses the unit of a literal. A "^" written directly after the unit, without spaces,
+// raises the unit, e.g. "2 m^3" is 2 m³, while a spaced one raises the whole literal, e.g. "2 m ^ 2" is 4 m².
+func (p *parser) parseLiteralUnit() (literalUnit, error) {
+	tok := p.next()
+	unit := literalUnit{span{tok.start, tok.end}, tok.text, 1}
+
+	if p.isOperator("^") && p.peek().start == tok.end {
+		p.next()
+		exponent, end, err := p.parseInteger()
+		if err != nil {
+			return literalUnit{}, err
+		}
+		unit.exponent, unit.end = exponent, end
+	}
+
+	return unit, nil
+}
+
+// isCall returns true if the next tokens are an identifier followed by "(".
+func (p *parser) isCall() bool {
+	return p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].kind == tokenOperator && p.tokens[p.pos+1].text == "("
+}
+
+// parseCall parses the comma-separated arguments of a function call.
+func (p *parser) parseCall(name token) (node, error) {
+	p.next()
+
+	var arguments []node
+	if !p.isOperator(")") {
+		for {
+			argument, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, argument)
+
+			if !p.isOperator(",") {
+				break
+			}
+			p.next()
+		}
+	}
+
+	closing, err := p.expect(")")
+	if err != nil {
+		return nil, err
+	}
+
+	return &callNode{span{name.start, closing.end}, name.text, arguments}, nil
+}
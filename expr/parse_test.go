@@ -0,0 +1,65 @@
+package expr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric/expr"
+	isser "github.com/matryer/is"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"120 km/h * 0.9",
+		"(5 GB) / (30 min)",
+		"-2.5e3 m + +1 m",
+		"sqrt(9 m^2)",
+		"max(1 s, 2 s, 3 s)",
+		"x^-2",
+		"20 °C",
+	}
+
+	for _, expression := range valid {
+		expression := expression
+		t.Run(expression, func(t *testing.T) {
+			is := isser.New(t)
+
+			e, err := expr.Parse(expression)
+			is.NoErr(err)
+			is.Equal(e.String(), expression)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		offending  string
+		start      int
+	}{
+		{name: "Empty", expression: "", offending: "", start: 0},
+		{name: "MissingOperand", expression: "2 m *", offending: "", start: 5},
+		{name: "UnclosedParenthesis", expression: "(1 m + 2 m", offending: "", start: 10},
+		{name: "UnexpectedParenthesis", expression: "1 m)", offending: ")", start: 3},
+		{name: "InvalidCharacter", expression: "1 m $ 2", offending: "$", start: 4},
+		{name: "FractionalExponent", expression: "m^1.5", offending: "1.5", start: 2},
+		{name: "InvalidNumber", expression: "1.2.3 m", offending: "1.2.3", start: 0},
+		{name: "MissingArgument", expression: "max(1 m,)", offending: ")", start: 8},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			_, err := expr.Parse(tt.expression)
+			is.True(errors.Is(err, expr.ErrSyntax))
+
+			var exprErr *expr.Error
+			is.True(errors.As(err, &exprErr))
+			is.Equal(exprErr.Start, tt.start)
+			is.Equal(tt.expression[exprErr.Start:exprErr.End], tt.offending)
+		})
+	}
+}
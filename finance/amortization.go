@@ -0,0 +1,118 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+// Installment is a single row of an amortization schedule.
+type Installment struct {
+	Period    int
+	Payment   money.Money
+	Interest  money.Money
+	Principal money.Money
+	Balance   money.Money
+}
+
+// AnnuityPayment returns the fixed payment repaying principal over the given number of periods
+// at the given rate per period, rounded to the precision of the Currency.
+func AnnuityPayment(principal money.Money, periodRate decimal.Decimal, periods int) (money.Money, error) {
+	if periods <= 0 {
+		return money.Money{}, fmt.Errorf("%w: %d periods", ErrInvalidPeriods, periods)
+	}
+
+	n := decimal.MustNew(int64(periods), 0)
+
+	if periodRate.IsZero() {
+		payment, err := principal.Decimal().Quo(n)
+		if err != nil {
+			return money.Money{}, fmt.Errorf("calculating annuity payment: %w", err)
+		}
+		return round(payment, principal.Currency()), nil
+	}
+
+	// payment = P * r / (1 - (1 + r)^-n)
+	factor, err := growthFactor(periodRate, n.Neg())
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating annuity payment: %w", err)
+	}
+
+	denominator, err := decimal.One.Sub(factor)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating annuity payment: %w", err)
+	}
+
+	numerator, err := principal.Decimal().Mul(periodRate)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating annuity payment: %w", err)
+	}
+
+	payment, err := numerator.Quo(denominator)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating annuity payment: %w", err)
+	}
+
+	return round(payment, principal.Currency()), nil
+}
+
+// AmortizationSchedule returns the schedule of level payments repaying principal at the nominal annual rate
+// with periodsPerYear payments a year over the given number of periods.
+// The final payment is adjusted so that the remaining balance is exactly zero.
+func AmortizationSchedule(principal money.Money, annualRate decimal.Decimal, periodsPerYear, periods int) ([]Installment, error) {
+	if periodsPerYear <= 0 {
+		return nil, fmt.Errorf("%w: %d periods per year", ErrInvalidPeriods, periodsPerYear)
+	}
+
+	periodRate, err := annualRate.Quo(decimal.MustNew(int64(periodsPerYear), 0))
+	if err != nil {
+		return nil, fmt.Errorf("calculating period rate: %w", err)
+	}
+
+	payment, err := AnnuityPayment(principal, periodRate, periods)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := principal.Currency()
+	balance := principal.Decimal()
+	schedule := make([]Installment, 0, periods)
+
+	for period := 1; period <= periods; period++ {
+		interest, err := balance.Mul(periodRate)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %w", period, err)
+		}
+		interest = interest.Round(currency.Decimal())
+
+		amount := payment.Decimal()
+		principalPart, err := amount.Sub(interest)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %w", period, err)
+		}
+
+		if period == periods {
+			principalPart = balance
+			amount, err = balance.Add(interest)
+			if err != nil {
+				return nil, fmt.Errorf("period %d: %w", period, err)
+			}
+		}
+
+		balance, err = balance.Sub(principalPart)
+		if err != nil {
+			return nil, fmt.Errorf("period %d: %w", period, err)
+		}
+
+		schedule = append(schedule, Installment{
+			Period:    period,
+			Payment:   money.NewMoneyFromDecimal(amount, currency),
+			Interest:  money.NewMoneyFromDecimal(interest, currency),
+			Principal: money.NewMoneyFromDecimal(principalPart, currency),
+			Balance:   money.NewMoneyFromDecimal(balance, currency),
+		})
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,52 @@
+package finance_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric/finance"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+	isser "github.com/matryer/is"
+)
+
+func TestAnnuityPayment(t *testing.T) {
+	is := isser.New(t)
+
+	payment, err := finance.AnnuityPayment(money.NewMoney(100000, money.USD), decimal.MustParse("0.01"), 12)
+	is.NoErr(err)
+	is.Equal(payment.MinorUnit(), uint64(8885))
+
+	payment, err = finance.AnnuityPayment(money.NewMoney(100000, money.USD), decimal.Zero, 3)
+	is.NoErr(err)
+	is.Equal(payment.MinorUnit(), uint64(33333))
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	is := isser.New(t)
+
+	principal := money.NewMoney(100000, money.USD)
+
+	schedule, err := finance.AmortizationSchedule(principal, decimal.MustParse("0.12"), 12, 12)
+	is.NoErr(err)
+	is.Equal(len(schedule), 12)
+
+	first := schedule[0]
+	is.Equal(first.Period, 1)
+	is.Equal(first.Payment.MinorUnit(), uint64(8885))
+	is.Equal(first.Interest.MinorUnit(), uint64(1000))
+	is.Equal(first.Principal.MinorUnit(), uint64(7885))
+	is.Equal(first.Balance.MinorUnit(), uint64(92115))
+
+	last := schedule[len(schedule)-1]
+	is.True(last.Balance.IsZero())
+
+	repaid := money.NewMoney(0, money.USD)
+	for _, installment := range schedule {
+		repaid, err = repaid.Add(installment.Principal)
+		is.NoErr(err)
+	}
+
+	equals, err := repaid.Equals(principal)
+	is.NoErr(err)
+	is.True(equals)
+}
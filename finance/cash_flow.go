@@ -0,0 +1,164 @@
+package finance
+
+import (
+	"fmt"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+const (
+	irrMaxIterations = 200
+	irrMaxExpansions = 40
+)
+
+var (
+	irrLowerBound = decimal.MustParse("-0.5")
+	irrUpperBound = decimal.One
+	irrTolerance  = decimal.MustNew(1, 12)
+)
+
+// NPV returns the net present value of cash flows discounted at the given rate per period.
+// The first cash flow happens at period 0 and is not discounted.
+// Precondition: all cash flows must be in the same Currency.
+func NPV(rate decimal.Decimal, cashFlows []money.Money) (money.Money, error) {
+	if len(cashFlows) == 0 {
+		return money.Money{}, fmt.Errorf("%w: no cash flows", ErrInvalidPeriods)
+	}
+
+	npv, err := presentValue(rate, cashFlows)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return round(npv, cashFlows[0].Currency()), nil
+}
+
+// IRR returns the internal rate of return per period, i.e. the rate for which the NPV of the cash flows is zero.
+// Cash flows must change sign at least once. The search starts between -50% and 100% and widens the bracket,
+// moving the upper bound up and the lower bound towards -100%, until the NPV changes sign.
+// The result is found by bisection and rounded to 10 decimal places.
+func IRR(cashFlows []money.Money) (decimal.Decimal, error) {
+	if len(cashFlows) < 2 {
+		return decimal.Decimal{}, fmt.Errorf("%w: at least two cash flows are required", ErrInvalidPeriods)
+	}
+
+	lo, hi, npvLo, err := bracketIRR(cashFlows)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	for i := 0; i < irrMaxIterations; i++ {
+		sum, err := lo.Add(hi)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		mid, err := sum.Quo(decimal.Two)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+
+		npvMid, err := presentValue(mid, cashFlows)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+
+		if npvMid.IsZero() {
+			return mid.Round(10), nil
+		}
+
+		if npvMid.Sign() == npvLo.Sign() {
+			lo, npvLo = mid, npvMid
+		} else {
+			hi = mid
+		}
+
+		width, err := hi.Sub(lo)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		if width.Less(irrTolerance) {
+			return mid.Round(10), nil
+		}
+	}
+
+	return decimal.Decimal{}, fmt.Errorf("%w: IRR did not converge", ErrNoSolution)
+}
+
+// bracketIRR returns rates around the IRR and the NPV at the lower one. It starts with -50% and 100%
+// and, while the NPV has the same sign at both, doubles the upper bound plus 100% and halves the distance of the lower
+// bound to -100%. A bound whose NPV cannot be computed, e.g. because the discount overflows, stops moving.
+func bracketIRR(cashFlows []money.Money) (decimal.Decimal, decimal.Decimal, decimal.Decimal, error) {
+	lo, hi := irrLowerBound, irrUpperBound
+
+	npvLo, err := presentValue(lo, cashFlows)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	npvHi, err := presentValue(hi, cashFlows)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, err
+	}
+
+	for i := 0; npvLo.Sign() == npvHi.Sign(); i++ {
+		if i == irrMaxExpansions {
+			return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: NPV does not change sign between %s and %s", ErrNoSolution, lo, hi)
+		}
+
+		moved := false
+		if next, err := hi.Mul(decimal.Two); err == nil {
+			if next, err = next.Add(decimal.One); err == nil {
+				if npv, err := presentValue(next, cashFlows); err == nil {
+					hi, npvHi, moved = next, npv, true
+				}
+			}
+		}
+		if next, err := lo.Sub(decimal.One); err == nil {
+			if next, err = next.Quo(decimal.Two); err == nil {
+				if npv, err := presentValue(next, cashFlows); err == nil {
+					lo, npvLo, moved = next, npv, true
+				}
+			}
+		}
+
+		if !moved {
+			return decimal.Decimal{}, decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: NPV does not change sign between %s and %s", ErrNoSolution, lo, hi)
+		}
+	}
+
+	return lo, hi, npvLo, nil
+}
+
+// presentValue returns the unrounded sum of discounted cash flows.
+func presentValue(rate decimal.Decimal, cashFlows []money.Money) (decimal.Decimal, error) {
+	currency := cashFlows[0].Currency()
+
+	base, err := decimal.One.Add(rate)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	pv := decimal.Zero
+	discount := decimal.One
+
+	for period, cf := range cashFlows {
+		if cf.Currency() != currency {
+			return decimal.Decimal{}, metric.ErrIncompatibleMetric{M1: currency, M2: cf.Currency()}
+		}
+
+		if period > 0 {
+			discount, err = discount.Mul(base)
+			if err != nil {
+				return decimal.Decimal{}, fmt.Errorf("period %d: %w", period, err)
+			}
+		}
+
+		pv, err = pv.AddQuo(cf.Decimal(), discount)
+		if err != nil {
+			return decimal.Decimal{}, fmt.Errorf("period %d: %w", period, err)
+		}
+	}
+
+	return pv, nil
+}
@@ -0,0 +1,56 @@
+package finance_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/finance"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+	isser "github.com/matryer/is"
+)
+
+func TestNPV(t *testing.T) {
+	is := isser.New(t)
+
+	cashFlows := []money.Money{
+		money.NewMoney(-100000, money.USD),
+		money.NewMoney(50000, money.USD),
+		money.NewMoney(50000, money.USD),
+		money.NewMoney(50000, money.USD),
+	}
+
+	npv, err := finance.NPV(decimal.MustParse("0.1"), cashFlows)
+	is.NoErr(err)
+	is.Equal(npv.MinorUnit(), uint64(24343))
+
+	_, err = finance.NPV(decimal.MustParse("0.1"), append(cashFlows, money.NewMoney(100, money.EUR)))
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: money.USD, M2: money.EUR})
+}
+
+func TestIRR(t *testing.T) {
+	is := isser.New(t)
+
+	irr, err := finance.IRR([]money.Money{
+		money.NewMoney(-100000, money.USD),
+		money.NewMoney(50000, money.USD),
+		money.NewMoney(50000, money.USD),
+		money.NewMoney(50000, money.USD),
+	})
+	is.NoErr(err)
+	is.Equal(irr.Round(6).String(), "0.233752")
+
+	irr, err = finance.IRR([]money.Money{
+		money.NewMoney(-10000, money.USD),
+		money.NewMoney(30000, money.USD),
+	})
+	is.NoErr(err)
+	is.Equal(irr.Round(6).String(), "2.000000")
+
+	_, err = finance.IRR([]money.Money{
+		money.NewMoney(100000, money.USD),
+		money.NewMoney(50000, money.USD),
+	})
+	is.True(errors.Is(err, finance.ErrNoSolution))
+}
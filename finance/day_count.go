@@ -0,0 +1,138 @@
+package finance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+// DayCount describes a day count convention used to calculate the fraction of a year between two dates.
+type DayCount interface {
+	Name() string
+
+	// YearFraction returns the fraction of a year between start and end.
+	// Precondition: start must not be after end.
+	YearFraction(start, end time.Time) (decimal.Decimal, error)
+}
+
+var (
+	// Thirty360 is the 30/360 US convention, assuming every month has 30 days and every year 360 days.
+	// It applies the end-of-month rules for February: the last day of February counts as the 30th
+	// when it starts the period, and when it ends a period that starts on the last day of February as well.
+	Thirty360 DayCount = dayCountImpl{name: "30/360", fractionFn: thirty360Fraction}
+
+	// Actual365Fixed is the ACT/365 Fixed convention, dividing the actual number of days by 365.
+	Actual365Fixed DayCount = dayCountImpl{name: "ACT/365", fractionFn: actual365Fraction}
+
+	// ActualActual is the ACT/ACT ISDA convention, dividing the days falling into leap years by 366
+	// and the remaining days by 365.
+	ActualActual DayCount = dayCountImpl{name: "ACT/ACT", fractionFn: actualActualFraction}
+)
+
+type dayCountImpl struct {
+	name       string
+	fractionFn func(start, end time.Time) (decimal.Decimal, error)
+}
+
+func (d dayCountImpl) Name() string {
+	return d.name
+}
+
+func (d dayCountImpl) String() string {
+	return d.name
+}
+
+func (d dayCountImpl) YearFraction(start, end time.Time) (decimal.Decimal, error) {
+	start, end = truncateToDate(start), truncateToDate(end)
+	if end.Before(start) {
+		return decimal.Decimal{}, fmt.Errorf("%s: end %s is before start %s", d.name, end.Format(time.DateOnly), start.Format(time.DateOnly))
+	}
+
+	return d.fractionFn(start, end)
+}
+
+// InterestForPeriod returns the simple interest accrued on principal at the annual rate between start and end,
+// measured with the given DayCount convention.
+func InterestForPeriod(principal money.Money, rate decimal.Decimal, dayCount DayCount, start, end time.Time) (money.Money, error) {
+	years, err := dayCount.YearFraction(start, end)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return SimpleInterest(principal, rate, years)
+}
+
+func thirty360Fraction(start, end time.Time) (decimal.Decimal, error) {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if isLastDayOfFebruary(start) {
+		if isLastDayOfFebruary(end) {
+			d2 = 30
+		}
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+
+	days := 360*(y2-y1) + 30*(int(m2)-int(m1)) + (d2 - d1)
+
+	return decimal.MustNew(int64(days), 0).Quo(decimal.MustNew(360, 0))
+}
+
+func actual365Fraction(start, end time.Time) (decimal.Decimal, error) {
+	return decimal.MustNew(int64(daysBetween(start, end)), 0).Quo(decimal.MustNew(365, 0))
+}
+
+func actualActualFraction(start, end time.Time) (decimal.Decimal, error) {
+	fraction := decimal.Zero
+
+	for current := start; current.Before(end); {
+		nextYear := time.Date(current.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		if nextYear.After(end) {
+			nextYear = end
+		}
+
+		daysInYear := int64(365)
+		if isLeapYear(current.Year()) {
+			daysInYear = 366
+		}
+
+		part, err := decimal.MustNew(int64(daysBetween(current, nextYear)), 0).Quo(decimal.MustNew(daysInYear, 0))
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+
+		fraction, err = fraction.Add(part)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+
+		current = nextYear
+	}
+
+	return fraction, nil
+}
+
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(start, end time.Time) int {
+	return int(end.Sub(start).Hours() / 24)
+}
+
+func isLastDayOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && t.AddDate(0, 0, 1).Month() == time.March
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,95 @@
+package finance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric/finance"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+	isser "github.com/matryer/is"
+)
+
+func TestDayCount(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		dayCount   finance.DayCount
+		start, end time.Time
+		expected   string
+	}{
+		{
+			name:     "Thirty360",
+			dayCount: finance.Thirty360,
+			start:    date(2024, time.January, 31),
+			end:      date(2024, time.March, 31),
+			expected: "0.166667",
+		},
+		{
+			name:     "Thirty360_FromEndOfFebruary",
+			dayCount: finance.Thirty360,
+			start:    date(2024, time.February, 29),
+			end:      date(2024, time.August, 31),
+			expected: "0.5",
+		},
+		{
+			name:     "Thirty360_BetweenEndsOfFebruary",
+			dayCount: finance.Thirty360,
+			start:    date(2023, time.February, 28),
+			end:      date(2024, time.February, 29),
+			expected: "1",
+		},
+		{
+			name:     "Thirty360_ToEndOfFebruary",
+			dayCount: finance.Thirty360,
+			start:    date(2023, time.January, 31),
+			end:      date(2023, time.February, 28),
+			expected: "0.077778",
+		},
+		{
+			name:     "Actual365Fixed",
+			dayCount: finance.Actual365Fixed,
+			start:    date(2024, time.January, 1),
+			end:      date(2025, time.January, 1),
+			expected: "1.002740",
+		},
+		{
+			name:     "ActualActual",
+			dayCount: finance.ActualActual,
+			start:    date(2023, time.July, 1),
+			end:      date(2024, time.July, 1),
+			expected: "1.001377",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			fraction, err := tt.dayCount.YearFraction(tt.start, tt.end)
+			is.NoErr(err)
+			is.Equal(fraction.Round(6).String(), tt.expected)
+
+			_, err = tt.dayCount.YearFraction(tt.end, tt.start)
+			is.True(err != nil)
+		})
+	}
+}
+
+func TestInterestForPeriod(t *testing.T) {
+	is := isser.New(t)
+
+	interest, err := finance.InterestForPeriod(
+		money.NewMoney(1000000, money.EUR),
+		decimal.MustParse("0.036"),
+		finance.Thirty360,
+		time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.July, 15, 0, 0, 0, 0, time.UTC),
+	)
+	is.NoErr(err)
+	is.Equal(interest.MinorUnit(), uint64(18000))
+}
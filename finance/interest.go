@@ -0,0 +1,148 @@
+// Package finance provides time-value-of-money calculations on top of money.Money.
+// All calculations use decimal arithmetic and results are rounded to the precision of the Currency.
+package finance
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+var (
+	ErrInvalidPeriods = errors.New("invalid number of periods")
+	ErrNoSolution     = errors.New("no solution found")
+)
+
+// SimpleInterest returns the interest accrued on principal at the annual rate over the given number of years.
+// The rate is expressed as a fraction, e.g. 0.05 for 5%.
+func SimpleInterest(principal money.Money, rate, years decimal.Decimal) (money.Money, error) {
+	interest, err := decimal.Prod(principal.Decimal(), rate, years)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating simple interest: %w", err)
+	}
+
+	return round(interest, principal.Currency()), nil
+}
+
+// CompoundInterest returns the interest accrued on principal at the nominal annual rate
+// compounded periodsPerYear times a year over the given number of years.
+func CompoundInterest(principal money.Money, rate decimal.Decimal, periodsPerYear int, years decimal.Decimal) (money.Money, error) {
+	if periodsPerYear <= 0 {
+		return money.Money{}, fmt.Errorf("%w: %d periods per year", ErrInvalidPeriods, periodsPerYear)
+	}
+
+	n := decimal.MustNew(int64(periodsPerYear), 0)
+
+	periodRate, err := rate.Quo(n)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating compound interest: %w", err)
+	}
+
+	periods, err := n.Mul(years)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating compound interest: %w", err)
+	}
+
+	factor, err := growthFactor(periodRate, periods)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating compound interest: %w", err)
+	}
+
+	growth, err := factor.Sub(decimal.One)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating compound interest: %w", err)
+	}
+
+	interest, err := principal.Decimal().Mul(growth)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("calculating compound interest: %w", err)
+	}
+
+	return round(interest, principal.Currency()), nil
+}
+
+// EffectiveAnnualRate converts a nominal annual rate (APR) compounded periodsPerYear times a year
+// into the effective annual rate (EAR).
+func EffectiveAnnualRate(apr decimal.Decimal, periodsPerYear int) (decimal.Decimal, error) {
+	if periodsPerYear <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %d periods per year", ErrInvalidPeriods, periodsPerYear)
+	}
+
+	periodRate, err := apr.Quo(decimal.MustNew(int64(periodsPerYear), 0))
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating EAR: %w", err)
+	}
+
+	factor, err := decimal.One.Add(periodRate)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating EAR: %w", err)
+	}
+
+	factor, err = factor.PowInt(periodsPerYear)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating EAR: %w", err)
+	}
+
+	return factor.Sub(decimal.One)
+}
+
+// NominalAnnualRate converts an effective annual rate (EAR) into the nominal annual rate (APR)
+// compounded periodsPerYear times a year.
+func NominalAnnualRate(ear decimal.Decimal, periodsPerYear int) (decimal.Decimal, error) {
+	if periodsPerYear <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %d periods per year", ErrInvalidPeriods, periodsPerYear)
+	}
+
+	n := decimal.MustNew(int64(periodsPerYear), 0)
+
+	exponent, err := decimal.One.Quo(n)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating APR: %w", err)
+	}
+
+	factor, err := growthFactor(ear, exponent)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating APR: %w", err)
+	}
+
+	periodRate, err := factor.Sub(decimal.One)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("calculating APR: %w", err)
+	}
+
+	return periodRate.Mul(n)
+}
+
+// growthFactor returns (1 + rate)^periods. Fractional periods are supported.
+func growthFactor(rate, periods decimal.Decimal) (decimal.Decimal, error) {
+	base, err := decimal.One.Add(rate)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	if periods.IsInt() {
+		whole, _, ok := periods.Int64(0)
+		if ok {
+			return base.PowInt(int(whole))
+		}
+	}
+
+	log, err := base.Log()
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	exponent, err := log.Mul(periods)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	return exponent.Exp()
+}
+
+// round creates Money rounded and padded to the decimal places of the currency.
+func round(amount decimal.Decimal, currency money.Currency) money.Money {
+	return money.NewMoneyFromDecimal(amount.Rescale(currency.Decimal()), currency)
+}
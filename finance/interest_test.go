@@ -0,0 +1,83 @@
+package finance_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric/finance"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+	isser "github.com/matryer/is"
+)
+
+func TestInterest(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(is *isser.I)
+	}{
+		{
+			name: "SimpleInterest",
+			check: func(is *isser.I) {
+				interest, err := finance.SimpleInterest(money.NewMoney(100000, money.USD), decimal.MustParse("0.05"), decimal.Two)
+				is.NoErr(err)
+				is.Equal(interest.MinorUnit(), uint64(10000))
+				is.Equal(interest.Currency(), money.USD)
+			},
+		},
+		{
+			name: "CompoundInterest_Annual",
+			check: func(is *isser.I) {
+				interest, err := finance.CompoundInterest(money.NewMoney(100000, money.USD), decimal.MustParse("0.05"), 1, decimal.Two)
+				is.NoErr(err)
+				is.Equal(interest.MinorUnit(), uint64(10250))
+			},
+		},
+		{
+			name: "CompoundInterest_Monthly",
+			check: func(is *isser.I) {
+				interest, err := finance.CompoundInterest(money.NewMoney(100000, money.USD), decimal.MustParse("0.12"), 12, decimal.One)
+				is.NoErr(err)
+				is.Equal(interest.MinorUnit(), uint64(12683))
+			},
+		},
+		{
+			name: "CompoundInterest_FractionalYears",
+			check: func(is *isser.I) {
+				interest, err := finance.CompoundInterest(money.NewMoney(100000, money.USD), decimal.MustParse("0.1"), 1, decimal.MustParse("0.5"))
+				is.NoErr(err)
+				is.Equal(interest.MinorUnit(), uint64(4881))
+			},
+		},
+		{
+			name: "CompoundInterest_InvalidPeriods",
+			check: func(is *isser.I) {
+				_, err := finance.CompoundInterest(money.NewMoney(100000, money.USD), decimal.MustParse("0.1"), 0, decimal.One)
+				is.True(errors.Is(err, finance.ErrInvalidPeriods))
+			},
+		},
+		{
+			name: "EffectiveAnnualRate",
+			check: func(is *isser.I) {
+				ear, err := finance.EffectiveAnnualRate(decimal.MustParse("0.12"), 12)
+				is.NoErr(err)
+				is.Equal(ear.Round(6).String(), "0.126825")
+			},
+		},
+		{
+			name: "NominalAnnualRate",
+			check: func(is *isser.I) {
+				apr, err := finance.NominalAnnualRate(decimal.MustParse("0.1268250301319697"), 12)
+				is.NoErr(err)
+				is.Equal(apr.Round(6).String(), "0.120000")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			tt.check(is)
+		})
+	}
+}
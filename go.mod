@@ -4,4 +4,8 @@ go 1.22.6
 
 require github.com/matryer/is v1.4.1
 
-require github.com/govalues/decimal v0.1.33 // indirect
+require (
+	github.com/BurntSushi/toml v1.6.0
+	github.com/govalues/decimal v0.1.33
+	gopkg.in/yaml.v3 v3.0.1
+)
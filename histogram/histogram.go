@@ -0,0 +1,309 @@
+// Package histogram buckets quantities, e.g. request sizes or latencies, and estimates their quantiles.
+//
+// A Histogram counts observations in buckets delimited by upper bounds in one unit. Observations in other
+// units of the same dimension are converted to the unit of the Histogram through metric.UnitConverter.
+// Bounds are built with LinearBuckets, ExponentialBuckets or given explicitly as quantities.
+package histogram
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ErrEmpty              = errors.New("no observations")
+	ErrInvalidBuckets     = errors.New("invalid buckets")
+	ErrInvalidQuantile    = errors.New("invalid quantile")
+	ErrIncompatibleBounds = errors.New("incompatible bucket bounds")
+)
+
+// LinearBuckets returns count bounds, the first at start and each the width above the previous one.
+// The width is converted to the unit of start.
+func LinearBuckets(start, width metric.Quantity, count int) ([]metric.Quantity, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("%w: %d buckets", ErrInvalidBuckets, count)
+	}
+	unit, ok := start.Metric().(metric.Unit)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", metric.ErrMetricIsNotUnit, start.Metric())
+	}
+	if width.Metric() != unit {
+		converted, err := metric.UnitConverter.Convert(width, unit)
+		if err != nil {
+			return nil, fmt.Errorf("converting %s to %s: %w", width, unit, err)
+		}
+		width = converted
+	}
+	if width.Amount() <= 0 {
+		return nil, fmt.Errorf("%w: width %s must be positive", ErrInvalidBuckets, width)
+	}
+
+	bounds := make([]metric.Quantity, count)
+	for i := range bounds {
+		bounds[i] = metric.NewQuantity(start.Amount()+float64(i)*width.Amount(), unit)
+	}
+	return bounds, nil
+}
+
+// ExponentialBuckets returns count bounds, the first at the positive start and each factor times the previous one.
+func ExponentialBuckets(start metric.Quantity, factor float64, count int) ([]metric.Quantity, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("%w: %d buckets", ErrInvalidBuckets, count)
+	}
+	if start.Amount() <= 0 {
+		return nil, fmt.Errorf("%w: start %s must be positive", ErrInvalidBuckets, start)
+	}
+	if factor <= 1 || math.IsNaN(factor) {
+		return nil, fmt.Errorf("%w: factor %v must be greater than 1", ErrInvalidBuckets, factor)
+	}
+
+	bounds := make([]metric.Quantity, count)
+	amount := start.Amount()
+	for i := range bounds {
+		bounds[i] = metric.NewQuantity(amount, start.Metric())
+		amount *= factor
+	}
+	return bounds, nil
+}
+
+// Bucket counts the observations above the bound of the previous Bucket and at most its UpperBound.
+// The UpperBound of the last Bucket is +Inf.
+type Bucket struct {
+	UpperBound metric.Quantity
+	Count      uint64
+}
+
+// Histogram counts quantities in buckets. It is safe for concurrent use.
+type Histogram struct {
+	mu       sync.Mutex
+	unit     metric.Unit
+	bounds   []float64
+	counts   []uint64
+	count    uint64
+	sum      float64
+	min, max float64
+}
+
+// New creates a Histogram in the unit with buckets up to the strictly increasing bounds, converted to the unit,
+// and a last bucket up to +Inf.
+func New(unit metric.Unit, bounds []metric.Quantity) (*Histogram, error) {
+	if len(bounds) == 0 {
+		return nil, fmt.Errorf("%w: no bounds", ErrInvalidBuckets)
+	}
+	if metric.IsLevel(unit) {
+		return nil, fmt.Errorf("%w: cannot sum observations in %s", metric.ErrLogarithmicArithmetic, unit)
+	}
+
+	h := &Histogram{
+		unit:   unit,
+		bounds: make([]float64, len(bounds)),
+		counts: make([]uint64, len(bounds)+1),
+	}
+	for i, bound := range bounds {
+		amount, err := h.amount(bound)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && amount <= h.bounds[i-1] {
+			return nil, fmt.Errorf("%w: %s is not above %s", ErrInvalidBuckets, bound, bounds[i-1])
+		}
+		h.bounds[i] = amount
+	}
+
+	return h, nil
+}
+
+// amount returns the amount of the Quantity in the unit of the Histogram.
+func (h *Histogram) amount(quantity metric.Quantity) (float64, error) {
+	if quantity.Metric() == h.unit {
+		return quantity.Amount(), nil
+	}
+
+	converted, err := metric.UnitConverter.Convert(quantity, h.unit)
+	if err != nil {
+		return 0, fmt.Errorf("converting %s to %s: %w", quantity, h.unit, err)
+	}
+	return converted.Amount(), nil
+}
+
+// Unit returns the unit of the Histogram.
+func (h *Histogram) Unit() metric.Unit {
+	return h.unit
+}
+
+// Observe counts the Quantity, converting it to the unit of the Histogram.
+// It returns metric.ErrNoConversion for quantities of another dimension.
+func (h *Histogram) Observe(quantity metric.Quantity) error {
+	amount, err := h.amount(quantity)
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(amount) {
+		return fmt.Errorf("%w: cannot observe %s", ErrInvalidBuckets, quantity)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.extend(amount, amount)
+	h.counts[h.bucket(amount)]++
+	h.count++
+	h.sum += amount
+
+	return nil
+}
+
+// bucket returns the index of the bucket of the amount.
+func (h *Histogram) bucket(amount float64) int {
+	lo, hi := 0, len(h.bounds)
+	for lo < hi {
+		middle := (lo + hi) / 2
+		if amount <= h.bounds[middle] {
+			hi = middle
+		} else {
+			lo = middle + 1
+		}
+	}
+	return lo
+}
+
+// extend widens the range of the observations to min and max, before the observations are counted.
+func (h *Histogram) extend(min, max float64) {
+	if h.count == 0 || min < h.min {
+		h.min = min
+	}
+	if h.count == 0 || max > h.max {
+		h.max = max
+	}
+}
+
+// Count returns the number of observations.
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.count
+}
+
+// Sum returns the sum of the observations in the unit of the Histogram.
+func (h *Histogram) Sum() metric.Quantity {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return metric.NewQuantity(h.sum, h.unit)
+}
+
+// Buckets returns the buckets in increasing order, ending with the bucket up to +Inf.
+func (h *Histogram) Buckets() []Bucket {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make([]Bucket, len(h.counts))
+	for i, count := range h.counts {
+		bound := math.Inf(1)
+		if i < len(h.bounds) {
+			bound = h.bounds[i]
+		}
+		buckets[i] = Bucket{UpperBound: metric.NewQuantity(bound, h.unit), Count: count}
+	}
+	return buckets
+}
+
+// Merge adds the observations of the other Histogram, whose bounds must equal the bounds of h once converted to its unit.
+func (h *Histogram) Merge(other *Histogram) error {
+	if h == other {
+		return fmt.Errorf("%w: cannot merge a histogram into itself", ErrIncompatibleBounds)
+	}
+
+	other.mu.Lock()
+	bounds := append([]float64(nil), other.bounds...)
+	counts := append([]uint64(nil), other.counts...)
+	count, sum, min, max := other.count, other.sum, other.min, other.max
+	other.mu.Unlock()
+
+	if len(bounds) != len(h.bounds) {
+		return fmt.Errorf("%w: %d and %d bounds", ErrIncompatibleBounds, len(h.bounds), len(bounds))
+	}
+	for i, bound := range bounds {
+		amount, err := h.amount(metric.NewQuantity(bound, other.unit))
+		if err != nil {
+			return err
+		}
+		if !approximately(amount, h.bounds[i]) {
+			return fmt.Errorf("%w: %v %s and %v %s", ErrIncompatibleBounds, h.bounds[i], h.unit, bound, other.unit)
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	// The sum is converted through the mean so that affine units, such as the degree Celsius, keep their offset once.
+	mean, err := h.amount(metric.NewQuantity(sum/float64(count), other.unit))
+	if err != nil {
+		return err
+	}
+	if min, err = h.amount(metric.NewQuantity(min, other.unit)); err != nil {
+		return err
+	}
+	if max, err = h.amount(metric.NewQuantity(max, other.unit)); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.extend(min, max)
+	for i, c := range counts {
+		h.counts[i] += c
+	}
+	h.count += count
+	h.sum += mean * float64(count)
+
+	return nil
+}
+
+func approximately(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// Quantile estimates the q-quantile of the observations, for q between 0 and 1, in the unit of the Histogram.
+// It interpolates linearly within the bucket of the quantile, bounding the first and the last buckets by the
+// smallest and the largest observations.
+func (h *Histogram) Quantile(q float64) (metric.Quantity, error) {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQuantile, q)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.count == 0 {
+		return nil, ErrEmpty
+	}
+
+	rank := q * float64(h.count)
+	var below uint64
+	for i, count := range h.counts {
+		if count == 0 || float64(below+count) < rank {
+			below += count
+			continue
+		}
+
+		lower, upper := h.min, h.max
+		if i > 0 && h.bounds[i-1] > lower {
+			lower = h.bounds[i-1]
+		}
+		if i < len(h.bounds) && h.bounds[i] < upper {
+			upper = h.bounds[i]
+		}
+
+		fraction := (rank - float64(below)) / float64(count)
+		return metric.NewQuantity(lower+fraction*(upper-lower), h.unit), nil
+	}
+
+	return metric.NewQuantity(h.max, h.unit), nil
+}
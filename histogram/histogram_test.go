@@ -0,0 +1,218 @@
+package histogram_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/histogram"
+	isser "github.com/matryer/is"
+)
+
+func amounts(quantities []metric.Quantity) []float64 {
+	result := make([]float64, len(quantities))
+	for i, q := range quantities {
+		result[i] = q.Amount()
+	}
+	return result
+}
+
+func counts(h *histogram.Histogram) []uint64 {
+	buckets := h.Buckets()
+	result := make([]uint64, len(buckets))
+	for i, b := range buckets {
+		result[i] = b.Count
+	}
+	return result
+}
+
+func mustHistogram(t *testing.T, unit metric.Unit, bounds []metric.Quantity, err error) *histogram.Histogram {
+	t.Helper()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := histogram.New(unit, bounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestLinearBuckets(t *testing.T) {
+	is := isser.New(t)
+
+	bounds, err := histogram.LinearBuckets(metric.NewQuantity(1, metric.Second), metric.NewQuantity(500, metric.Millisecond), 4)
+	is.NoErr(err)
+	is.Equal(amounts(bounds), []float64{1, 1.5, 2, 2.5})
+	is.Equal(bounds[3].Metric(), metric.Second)
+
+	_, err = histogram.LinearBuckets(metric.NewQuantity(1, metric.Second), metric.NewQuantity(0, metric.Second), 4)
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+
+	_, err = histogram.LinearBuckets(metric.NewQuantity(1, metric.Second), metric.NewQuantity(1, metric.Meter), 4)
+	is.True(errors.Is(err, metric.ErrNoConversion))
+}
+
+func TestExponentialBuckets(t *testing.T) {
+	is := isser.New(t)
+
+	bounds, err := histogram.ExponentialBuckets(metric.NewQuantity(100, metric.Byte), 10, 3)
+	is.NoErr(err)
+	is.Equal(amounts(bounds), []float64{100, 1000, 10000})
+
+	_, err = histogram.ExponentialBuckets(metric.NewQuantity(100, metric.Byte), 1, 3)
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+
+	_, err = histogram.ExponentialBuckets(metric.NewQuantity(0, metric.Byte), 2, 3)
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+
+	_, err = histogram.ExponentialBuckets(metric.NewQuantity(1, metric.Byte), 2, 0)
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+}
+
+func TestNew(t *testing.T) {
+	is := isser.New(t)
+
+	h, err := histogram.New(metric.Millisecond, []metric.Quantity{
+		metric.NewQuantity(100, metric.Millisecond),
+		metric.NewQuantity(1, metric.Second),
+	})
+	is.NoErr(err)
+	buckets := h.Buckets()
+	is.Equal(len(buckets), 3)
+	is.Equal(buckets[1].UpperBound.Amount(), 1000.0)
+	is.Equal(buckets[1].UpperBound.Metric(), metric.Millisecond)
+	is.True(math.IsInf(buckets[2].UpperBound.Amount(), 1))
+
+	_, err = histogram.New(metric.Second, nil)
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+
+	_, err = histogram.New(metric.Second, []metric.Quantity{metric.NewQuantity(2, metric.Second), metric.NewQuantity(1, metric.Second)})
+	is.True(errors.Is(err, histogram.ErrInvalidBuckets))
+
+	_, err = histogram.New(metric.DecibelMilliwatt, []metric.Quantity{metric.NewQuantity(1, metric.DecibelMilliwatt)})
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+}
+
+func TestObserve(t *testing.T) {
+	is := isser.New(t)
+
+	bounds, err := histogram.LinearBuckets(metric.NewQuantity(100, metric.Millisecond), metric.NewQuantity(100, metric.Millisecond), 3)
+	h := mustHistogram(t, metric.Millisecond, bounds, err)
+
+	is.NoErr(h.Observe(metric.NewQuantity(50, metric.Millisecond)))
+	is.NoErr(h.Observe(metric.NewQuantity(100, metric.Millisecond)))
+	is.NoErr(h.Observe(metric.NewQuantity(0.25, metric.Second)))
+	is.NoErr(h.Observe(metric.NewQuantity(1, metric.Minute)))
+
+	is.Equal(counts(h), []uint64{2, 0, 1, 1})
+	is.Equal(h.Count(), uint64(4))
+	is.Equal(h.Sum().Amount(), 60400.0)
+	is.Equal(h.Sum().Metric(), metric.Millisecond)
+
+	err = h.Observe(metric.NewQuantity(1, metric.Meter))
+	is.True(errors.Is(err, metric.ErrNoConversion))
+	is.Equal(h.Count(), uint64(4))
+}
+
+func TestConcurrentObserve(t *testing.T) {
+	is := isser.New(t)
+
+	bounds, err := histogram.ExponentialBuckets(metric.NewQuantity(1, metric.Byte), 2, 10)
+	h := mustHistogram(t, metric.Byte, bounds, err)
+
+	var wg sync.WaitGroup
+	for p := 0; p < 8; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if err := h.Observe(metric.NewQuantity(float64(i), metric.Byte)); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	is.Equal(h.Count(), uint64(800))
+	is.Equal(h.Sum().Amount(), 8*4950.0)
+}
+
+func TestMerge(t *testing.T) {
+	is := isser.New(t)
+
+	seconds, err := histogram.LinearBuckets(metric.NewQuantity(1, metric.Second), metric.NewQuantity(1, metric.Second), 2)
+	h := mustHistogram(t, metric.Second, seconds, err)
+	is.NoErr(h.Observe(metric.NewQuantity(0.5, metric.Second)))
+
+	milliseconds, err := histogram.LinearBuckets(metric.NewQuantity(1000, metric.Millisecond), metric.NewQuantity(1000, metric.Millisecond), 2)
+	other := mustHistogram(t, metric.Millisecond, milliseconds, err)
+	is.NoErr(other.Observe(metric.NewQuantity(1500, metric.Millisecond)))
+	is.NoErr(other.Observe(metric.NewQuantity(3000, metric.Millisecond)))
+
+	is.NoErr(h.Merge(other))
+	is.Equal(counts(h), []uint64{1, 1, 1})
+	is.Equal(h.Count(), uint64(3))
+	is.Equal(h.Sum().Amount(), 5.0)
+
+	maximum, err := h.Quantile(1)
+	is.NoErr(err)
+	is.Equal(maximum.Amount(), 3.0)
+
+	different := mustHistogram(t, metric.Second, []metric.Quantity{metric.NewQuantity(1, metric.Second)}, nil)
+	is.True(errors.Is(h.Merge(different), histogram.ErrIncompatibleBounds))
+	is.True(errors.Is(h.Merge(h), histogram.ErrIncompatibleBounds))
+
+	bytes := mustHistogram(t, metric.Byte, []metric.Quantity{metric.NewQuantity(1, metric.Byte), metric.NewQuantity(2, metric.Byte)}, nil)
+	is.True(errors.Is(h.Merge(bytes), metric.ErrNoConversion))
+}
+
+func TestQuantile(t *testing.T) {
+	bounds, err := histogram.LinearBuckets(metric.NewQuantity(10, metric.Millisecond), metric.NewQuantity(10, metric.Millisecond), 3)
+	h := mustHistogram(t, metric.Millisecond, bounds, err)
+	for _, amount := range []float64{5, 12, 14, 16, 18, 25, 45} {
+		if err := h.Observe(metric.NewQuantity(amount, metric.Millisecond)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		q        float64
+		expected float64
+	}{
+		{name: "Minimum", q: 0, expected: 5},
+		{name: "FirstBucket", q: 1.0 / 14, expected: 7.5},
+		{name: "Median", q: 0.5, expected: 16.25},
+		{name: "Overflow", q: 13.0 / 14, expected: 37.5},
+		{name: "Maximum", q: 1, expected: 45},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			result, err := h.Quantile(tt.q)
+			is.NoErr(err)
+			is.True(math.Abs(result.Amount()-tt.expected) < 1e-9)
+			is.Equal(result.Metric(), metric.Millisecond)
+		})
+	}
+}
+
+func TestQuantileErrors(t *testing.T) {
+	is := isser.New(t)
+
+	h := mustHistogram(t, metric.Second, []metric.Quantity{metric.NewQuantity(1, metric.Second)}, nil)
+
+	_, err := h.Quantile(0.5)
+	is.True(errors.Is(err, histogram.ErrEmpty))
+
+	_, err = h.Quantile(1.5)
+	is.True(errors.Is(err, histogram.ErrInvalidQuantile))
+}
@@ -0,0 +1,7 @@
+// Package imperial declares imperial units of length, speed, mass and temperature, e.g. the Mile and the Pound,
+// with their conversions to SI units. Importing the package, even as _, registers them with metric.LookupUnit.
+//
+// The units are generated by metricgen from units.json; run go generate after changing it.
+package imperial
+
+//go:generate go run ../cmd/metricgen -spec units.json -out units_gen.go
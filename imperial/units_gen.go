@@ -0,0 +1,198 @@
+// Code generated by metricgen from units.json; DO NOT EDIT.
+
+package imperial
+
+import (
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ImperialSystemOfUnits = metric.NewSystemOfUnits("Imperial", "UK Weights and Measures Act 1985")
+)
+
+// init registers the systems of units and the aliases of the units, so that metric.LookupUnit finds the units.
+func init() {
+	metric.RegisterSystemOfUnits(ImperialSystemOfUnits)
+	metric.RegisterAlias(Inch, "inches")
+	metric.RegisterAlias(Foot, "feet")
+	metric.RegisterAlias(Yard, "yards")
+	metric.RegisterAlias(Mile, "miles")
+	metric.RegisterAlias(MilePerHour, "mi/h", "miles per hour")
+	metric.RegisterAlias(Pound, "pounds", "lbs")
+	metric.RegisterAlias(Ounce, "ounces")
+	metric.RegisterAlias(DegreeFahrenheit, "Fahrenheit")
+}
+
+var (
+	// Inch is the inch (in).
+	Inch = metric.NewBaseUnit("inch", "The inch is equal to 25.4 millimeters", "in", ImperialSystemOfUnits)
+	// Foot is the foot (ft).
+	Foot = metric.NewBaseUnit("foot", "The foot is equal to 12 inches, or 0.3048 meters", "ft", ImperialSystemOfUnits)
+	// Yard is the yard (yd).
+	Yard = metric.NewBaseUnit("yard", "The yard is equal to 3 feet, or 0.9144 meters", "yd", ImperialSystemOfUnits)
+	// Mile is the mile (mi).
+	Mile = metric.NewBaseUnit("mile", "The mile is equal to 1760 yards, or 1609.344 meters", "mi", ImperialSystemOfUnits)
+	// MilePerHour is the mile per hour (mph).
+	MilePerHour = metric.NewDerivedUnit("mile per hour", "The mile per hour is the speed of one mile travelled in one hour", "mph", ImperialSystemOfUnits,
+		metric.NewDerivedUnitTerm(Mile, 1),
+		metric.NewDerivedUnitTerm(metric.Hour, -1),
+	)
+	// Pound is the pound (lb).
+	Pound = metric.NewBaseUnit("pound", "The pound is equal to 0.45359237 kilograms", "lb", ImperialSystemOfUnits)
+	// Ounce is the ounce (oz).
+	Ounce = metric.NewBaseUnit("ounce", "The ounce is equal to 1/16 of a pound", "oz", ImperialSystemOfUnits)
+	// DegreeFahrenheit is the degree Fahrenheit (°F).
+	DegreeFahrenheit = metric.NewBaseUnit("degree Fahrenheit", "The degree Fahrenheit is 5/9 of a degree Celsius, with water freezing at 32 °F", "°F", ImperialSystemOfUnits)
+)
+
+var (
+	// InchToMeter converts Inch to metric.Meter.
+	InchToMeter = metric.NewStandardConversion(Inch, metric.Meter, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.0254, metric.Meter), nil
+	})
+
+	// MeterToInch converts metric.Meter to Inch.
+	MeterToInch = metric.NewStandardConversion(metric.Meter, Inch, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.0254, Inch), nil
+	})
+
+	// InchToYard converts Inch to Yard.
+	InchToYard = metric.NewStandardConversion(Inch, Yard, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(1.0/36), Yard), nil
+	})
+
+	// YardToInch converts Yard to Inch.
+	YardToInch = metric.NewStandardConversion(Yard, Inch, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/(1.0/36), Inch), nil
+	})
+
+	// InchToMile converts Inch to Mile.
+	InchToMile = metric.NewStandardConversion(Inch, Mile, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(1.0/63360), Mile), nil
+	})
+
+	// MileToInch converts Mile to Inch.
+	MileToInch = metric.NewStandardConversion(Mile, Inch, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/(1.0/63360), Inch), nil
+	})
+
+	// FootToMeter converts Foot to metric.Meter.
+	FootToMeter = metric.NewStandardConversion(Foot, metric.Meter, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.3048, metric.Meter), nil
+	})
+
+	// MeterToFoot converts metric.Meter to Foot.
+	MeterToFoot = metric.NewStandardConversion(metric.Meter, Foot, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.3048, Foot), nil
+	})
+
+	// FootToInch converts Foot to Inch.
+	FootToInch = metric.NewStandardConversion(Foot, Inch, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*12, Inch), nil
+	})
+
+	// InchToFoot converts Inch to Foot.
+	InchToFoot = metric.NewStandardConversion(Inch, Foot, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/12, Foot), nil
+	})
+
+	// FootToYard converts Foot to Yard.
+	FootToYard = metric.NewStandardConversion(Foot, Yard, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(1.0/3), Yard), nil
+	})
+
+	// YardToFoot converts Yard to Foot.
+	YardToFoot = metric.NewStandardConversion(Yard, Foot, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/(1.0/3), Foot), nil
+	})
+
+	// FootToMile converts Foot to Mile.
+	FootToMile = metric.NewStandardConversion(Foot, Mile, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(1.0/5280), Mile), nil
+	})
+
+	// MileToFoot converts Mile to Foot.
+	MileToFoot = metric.NewStandardConversion(Mile, Foot, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/(1.0/5280), Foot), nil
+	})
+
+	// YardToMeter converts Yard to metric.Meter.
+	YardToMeter = metric.NewStandardConversion(Yard, metric.Meter, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.9144, metric.Meter), nil
+	})
+
+	// MeterToYard converts metric.Meter to Yard.
+	MeterToYard = metric.NewStandardConversion(metric.Meter, Yard, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.9144, Yard), nil
+	})
+
+	// YardToMile converts Yard to Mile.
+	YardToMile = metric.NewStandardConversion(Yard, Mile, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(1.0/1760), Mile), nil
+	})
+
+	// MileToYard converts Mile to Yard.
+	MileToYard = metric.NewStandardConversion(Mile, Yard, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/(1.0/1760), Yard), nil
+	})
+
+	// MileToMeter converts Mile to metric.Meter.
+	MileToMeter = metric.NewStandardConversion(Mile, metric.Meter, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*1609.344, metric.Meter), nil
+	})
+
+	// MeterToMile converts metric.Meter to Mile.
+	MeterToMile = metric.NewStandardConversion(metric.Meter, Mile, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/1609.344, Mile), nil
+	})
+
+	// MilePerHourToSpeed converts MilePerHour to metric.Speed.
+	MilePerHourToSpeed = metric.NewStandardConversion(MilePerHour, metric.Speed, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.44704, metric.Speed), nil
+	})
+
+	// SpeedToMilePerHour converts metric.Speed to MilePerHour.
+	SpeedToMilePerHour = metric.NewStandardConversion(metric.Speed, MilePerHour, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.44704, MilePerHour), nil
+	})
+
+	// PoundToKilogram converts Pound to metric.Kilogram.
+	PoundToKilogram = metric.NewStandardConversion(Pound, metric.Kilogram, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.45359237, metric.Kilogram), nil
+	})
+
+	// KilogramToPound converts metric.Kilogram to Pound.
+	KilogramToPound = metric.NewStandardConversion(metric.Kilogram, Pound, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.45359237, Pound), nil
+	})
+
+	// OunceToPound converts Ounce to Pound.
+	OunceToPound = metric.NewStandardConversion(Ounce, Pound, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.0625, Pound), nil
+	})
+
+	// PoundToOunce converts Pound to Ounce.
+	PoundToOunce = metric.NewStandardConversion(Pound, Ounce, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.0625, Ounce), nil
+	})
+
+	// OunceToKilogram converts Ounce to metric.Kilogram.
+	OunceToKilogram = metric.NewStandardConversion(Ounce, metric.Kilogram, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*0.028349523125, metric.Kilogram), nil
+	})
+
+	// KilogramToOunce converts metric.Kilogram to Ounce.
+	KilogramToOunce = metric.NewStandardConversion(metric.Kilogram, Ounce, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()/0.028349523125, Ounce), nil
+	})
+
+	// DegreeFahrenheitToCelsius converts DegreeFahrenheit to metric.Celsius.
+	DegreeFahrenheitToCelsius = metric.NewStandardConversion(DegreeFahrenheit, metric.Celsius, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity(quantity.Amount()*(5.0/9)-160.0/9, metric.Celsius), nil
+	})
+
+	// CelsiusToDegreeFahrenheit converts metric.Celsius to DegreeFahrenheit.
+	CelsiusToDegreeFahrenheit = metric.NewStandardConversion(metric.Celsius, DegreeFahrenheit, func(quantity metric.Quantity) (metric.Quantity, error) {
+		return metric.NewQuantity((quantity.Amount()+160.0/9)/(5.0/9), DegreeFahrenheit), nil
+	})
+)
@@ -0,0 +1,115 @@
+// Code generated by metricgen from units.json; DO NOT EDIT.
+
+package imperial
+
+import (
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+)
+
+func TestLookupUnit(t *testing.T) {
+	tests := []struct {
+		symbol string
+		want   metric.Unit
+	}{
+		{"in", Inch},
+		{"inch", Inch},
+		{"inches", Inch},
+		{"ft", Foot},
+		{"foot", Foot},
+		{"feet", Foot},
+		{"yd", Yard},
+		{"yard", Yard},
+		{"yards", Yard},
+		{"mi", Mile},
+		{"mile", Mile},
+		{"miles", Mile},
+		{"mph", MilePerHour},
+		{"mile per hour", MilePerHour},
+		{"mi/h", MilePerHour},
+		{"miles per hour", MilePerHour},
+		{"lb", Pound},
+		{"pound", Pound},
+		{"pounds", Pound},
+		{"lbs", Pound},
+		{"oz", Ounce},
+		{"ounce", Ounce},
+		{"ounces", Ounce},
+		{"°F", DegreeFahrenheit},
+		{"degree Fahrenheit", DegreeFahrenheit},
+		{"Fahrenheit", DegreeFahrenheit},
+	}
+
+	for _, tt := range tests {
+		got, err := metric.LookupUnit(tt.symbol)
+		if err != nil {
+			t.Fatalf("metric.LookupUnit(%q) error: %v", tt.symbol, err)
+		}
+		if got != tt.want {
+			t.Errorf("metric.LookupUnit(%q) = %s, want %s", tt.symbol, got.Name(), tt.want.Name())
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		unit   metric.Unit
+		target metric.Unit
+		want   float64
+	}{
+		{Inch, metric.Meter, 0.0254},
+		{Inch, Yard, (1.0 / 36)},
+		{Inch, Mile, (1.0 / 63360)},
+		{Foot, metric.Meter, 0.3048},
+		{Foot, Inch, 12},
+		{Foot, Yard, (1.0 / 3)},
+		{Foot, Mile, (1.0 / 5280)},
+		{Yard, metric.Meter, 0.9144},
+		{Yard, Mile, (1.0 / 1760)},
+		{Mile, metric.Meter, 1609.344},
+		{MilePerHour, metric.Speed, 0.44704},
+		{Pound, metric.Kilogram, 0.45359237},
+		{Ounce, Pound, 0.0625},
+		{Ounce, metric.Kilogram, 0.028349523125},
+		{DegreeFahrenheit, metric.Celsius, (5.0 / 9) - 160.0/9},
+	}
+
+	for _, tt := range tests {
+		converted, err := metric.UnitConverter.Convert(metric.NewQuantity(1, tt.unit), tt.target)
+		if err != nil {
+			t.Fatalf("converting 1 %s to %s: %v", tt.unit, tt.target, err)
+		}
+		if !approximately(converted.Amount(), tt.want) {
+			t.Errorf("1 %s = %v %s, want %v", tt.unit, converted.Amount(), tt.target, tt.want)
+		}
+
+		back, err := metric.UnitConverter.Convert(converted, tt.unit)
+		if err != nil {
+			t.Fatalf("converting %s back to %s: %v", converted, tt.unit, err)
+		}
+		if !approximately(back.Amount(), 1) {
+			t.Errorf("%s = %v %s, want 1", converted, back.Amount(), tt.unit)
+		}
+	}
+}
+
+func TestSystemsOfUnits(t *testing.T) {
+	registered := make(map[metric.SystemOfUnits]bool)
+	for _, system := range metric.SystemsOfUnits() {
+		registered[system] = true
+	}
+
+	for _, system := range []metric.SystemOfUnits{
+		ImperialSystemOfUnits,
+	} {
+		if !registered[system] {
+			t.Errorf("%s is not registered", system.Name())
+		}
+	}
+}
+
+func approximately(got, want float64) bool {
+	return math.Abs(got-want) <= 1e-9*math.Max(1, math.Abs(want))
+}
@@ -0,0 +1,19 @@
+// Package fmtstate holds the fmt.Formatter helpers shared by the metric and money packages.
+package fmtstate
+
+import "fmt"
+
+// Pad writes the string padded to the width, on the left unless the '-' flag is given.
+func Pad(f fmt.State, s string) {
+	width, ok := f.Width()
+	if !ok {
+		fmt.Fprint(f, s)
+		return
+	}
+
+	if f.Flag('-') {
+		fmt.Fprintf(f, "%-*s", width, s)
+		return
+	}
+	fmt.Fprintf(f, "%*s", width, s)
+}
@@ -0,0 +1,73 @@
+// Package ledger implements a double-entry bookkeeping ledger on top of money.Money.
+package ledger
+
+import (
+	"fmt"
+)
+
+// AccountType classifies an Account and determines its normal balance.
+type AccountType int
+
+const (
+	Asset AccountType = iota
+	Liability
+	Equity
+	Income
+	Expense
+)
+
+func (t AccountType) String() string {
+	switch t {
+	case Asset:
+		return "asset"
+	case Liability:
+		return "liability"
+	case Equity:
+		return "equity"
+	case Income:
+		return "income"
+	case Expense:
+		return "expense"
+	default:
+		return fmt.Sprintf("AccountType(%d)", int(t))
+	}
+}
+
+// DebitNormal returns true if accounts of this type normally carry a debit balance.
+func (t AccountType) DebitNormal() bool {
+	return t == Asset || t == Expense
+}
+
+func (t AccountType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *AccountType) UnmarshalText(text []byte) error {
+	for _, candidate := range []AccountType{Asset, Liability, Equity, Income, Expense} {
+		if candidate.String() == string(text) {
+			*t = candidate
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown account type %q", text)
+}
+
+// Account is a named bucket postings are made to.
+type Account struct {
+	Code string
+	Name string
+	Type AccountType
+}
+
+func NewAccount(code, name string, accountType AccountType) Account {
+	return Account{
+		Code: code,
+		Name: name,
+		Type: accountType,
+	}
+}
+
+func (a Account) String() string {
+	return fmt.Sprintf("%s %s", a.Code, a.Name)
+}
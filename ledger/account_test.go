@@ -0,0 +1,39 @@
+package ledger_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric/ledger"
+	isser "github.com/matryer/is"
+)
+
+func TestAccountType(t *testing.T) {
+	tests := []struct {
+		name        string
+		accountType ledger.AccountType
+		text        string
+		debitNormal bool
+	}{
+		{name: "Asset", accountType: ledger.Asset, text: "asset", debitNormal: true},
+		{name: "Liability", accountType: ledger.Liability, text: "liability", debitNormal: false},
+		{name: "Equity", accountType: ledger.Equity, text: "equity", debitNormal: false},
+		{name: "Income", accountType: ledger.Income, text: "income", debitNormal: false},
+		{name: "Expense", accountType: ledger.Expense, text: "expense", debitNormal: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			text, err := tt.accountType.MarshalText()
+			is.NoErr(err)
+			is.Equal(string(text), tt.text)
+			is.Equal(tt.accountType.DebitNormal(), tt.debitNormal)
+
+			var parsed ledger.AccountType
+			is.NoErr(parsed.UnmarshalText(text))
+			is.Equal(parsed, tt.accountType)
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package ledger
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+var (
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// fileStore is a Store appending every account and transaction as a JSON line to a file.
+// Reads are served from memory; the file is replayed when the store is opened.
+// Records reach memory only once they are written, so a failed write leaves the store unchanged.
+type fileStore struct {
+	memoryStore
+	path string
+
+	// writeMu serializes writes, so that no record is added between checking for a duplicate and saving.
+	writeMu sync.Mutex
+}
+
+type fileRecord struct {
+	Account     *fileAccount     `json:"account,omitempty"`
+	Transaction *fileTransaction `json:"transaction,omitempty"`
+}
+
+type fileAccount struct {
+	Code string      `json:"code"`
+	Name string      `json:"name"`
+	Type AccountType `json:"type"`
+}
+
+type fileTransaction struct {
+	ID          string        `json:"id"`
+	Date        time.Time     `json:"date"`
+	Description string        `json:"description"`
+	Reverses    string        `json:"reverses,omitempty"`
+	Postings    []filePosting `json:"postings"`
+}
+
+type filePosting struct {
+	Account  string `json:"account"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewFileStore opens or creates a Store backed by the file at path.
+// Only currencies registered in money.ISOCurrencies can be stored.
+func NewFileStore(path string) (Store, error) {
+	s := &fileStore{path: path}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening ledger file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var record fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("ledger file line %d: %w", line, err)
+		}
+
+		switch {
+		case record.Account != nil:
+			err = s.memoryStore.SaveAccount(Account(*record.Account))
+		case record.Transaction != nil:
+			var tx Transaction
+			tx, err = record.Transaction.toTransaction()
+			if err == nil {
+				err = s.memoryStore.Append(tx)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ledger file line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading ledger file: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *fileStore) SaveAccount(account Account) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, err := s.memoryStore.Account(account.Code); err == nil {
+		return ErrDuplicateAccount
+	}
+
+	acc := fileAccount(account)
+	if err := s.write(fileRecord{Account: &acc}); err != nil {
+		return err
+	}
+
+	return s.memoryStore.SaveAccount(account)
+}
+
+func (s *fileStore) Append(tx Transaction) error {
+	record, err := newFileTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, err := s.memoryStore.Transaction(tx.id); err == nil {
+		return ErrDuplicateTransaction
+	}
+
+	if err := s.write(fileRecord{Transaction: &record}); err != nil {
+		return err
+	}
+
+	return s.memoryStore.Append(tx)
+}
+
+func (s *fileStore) write(record fileRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("encoding ledger record: %w", err)
+	}
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening ledger file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing ledger file: %w", err)
+	}
+
+	return nil
+}
+
+func newFileTransaction(tx Transaction) (fileTransaction, error) {
+	postings := make([]filePosting, len(tx.postings))
+	for i, p := range tx.postings {
+		code := p.Amount.Currency().Code()
+		if c, ok := money.ISOCurrencies.Get(code); !ok || c != p.Amount.Currency() {
+			return fileTransaction{}, fmt.Errorf("transaction %q: %w %q", tx.id, ErrUnknownCurrency, code)
+		}
+
+		postings[i] = filePosting{
+			Account:  p.Account,
+			Amount:   p.Amount.Decimal().String(),
+			Currency: code,
+		}
+	}
+
+	return fileTransaction{
+		ID:          tx.id,
+		Date:        tx.date,
+		Description: tx.description,
+		Reverses:    tx.reverses,
+		Postings:    postings,
+	}, nil
+}
+
+func (r fileTransaction) toTransaction() (Transaction, error) {
+	postings := make([]Posting, len(r.Postings))
+	for i, p := range r.Postings {
+		currency, ok := money.ISOCurrencies.Get(p.Currency)
+		if !ok {
+			return Transaction{}, fmt.Errorf("transaction %q: %w %q", r.ID, ErrUnknownCurrency, p.Currency)
+		}
+
+		amount, err := decimal.Parse(p.Amount)
+		if err != nil {
+			return Transaction{}, fmt.Errorf("transaction %q: %w", r.ID, err)
+		}
+
+		postings[i] = Posting{
+			Account: p.Account,
+			Amount:  money.NewMoneyFromDecimal(amount, currency),
+		}
+	}
+
+	tx := Transaction{
+		id:          r.ID,
+		date:        r.Date,
+		description: r.Description,
+		postings:    postings,
+		reverses:    r.Reverses,
+	}
+
+	return tx, tx.validate()
+}
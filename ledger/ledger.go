@@ -0,0 +1,280 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IAmRadek/metric/money"
+)
+
+var (
+	ErrAlreadyReversed = errors.New("transaction already reversed")
+)
+
+// Ledger is a double-entry journal of transactions posted to accounts kept in a Store.
+type Ledger struct {
+	mu    sync.Mutex
+	store Store
+}
+
+// New creates a Ledger using the given Store.
+func New(store Store) *Ledger {
+	return &Ledger{
+		store: store,
+	}
+}
+
+// OpenAccount adds the account to the Ledger.
+func (l *Ledger) OpenAccount(account Account) error {
+	if err := l.store.SaveAccount(account); err != nil {
+		return fmt.Errorf("account %q: %w", account.Code, err)
+	}
+	return nil
+}
+
+// Post appends the Transaction to the journal.
+// All accounts referenced by postings must be opened first.
+func (l *Ledger) Post(tx Transaction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.post(tx)
+}
+
+// Reverse posts a Transaction undoing the Transaction with the given id.
+// A Transaction can be reversed only once.
+func (l *Ledger) Reverse(id, reversalID string, date time.Time, description string) (Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	original, err := l.store.Transaction(id)
+	if err != nil {
+		return Transaction{}, fmt.Errorf("transaction %q: %w", id, err)
+	}
+
+	transactions, err := l.store.Transactions()
+	if err != nil {
+		return Transaction{}, err
+	}
+	for _, tx := range transactions {
+		if tx.reverses == id {
+			return Transaction{}, fmt.Errorf("transaction %q: %w by %q", id, ErrAlreadyReversed, tx.id)
+		}
+	}
+
+	reversal := original.Reversal(reversalID, date, description)
+	if err := l.post(reversal); err != nil {
+		return Transaction{}, err
+	}
+
+	return reversal, nil
+}
+
+func (l *Ledger) post(tx Transaction) error {
+	if err := tx.validate(); err != nil {
+		return err
+	}
+
+	for _, p := range tx.postings {
+		if _, err := l.store.Account(p.Account); err != nil {
+			return fmt.Errorf("transaction %q: account %q: %w", tx.id, p.Account, err)
+		}
+	}
+
+	if err := l.store.Append(tx); err != nil {
+		return fmt.Errorf("transaction %q: %w", tx.id, err)
+	}
+
+	return nil
+}
+
+// Journal returns all transactions in the order they were posted.
+func (l *Ledger) Journal() ([]Transaction, error) {
+	return l.store.Transactions()
+}
+
+// BalanceEntry is a single row of a running balance.
+type BalanceEntry struct {
+	TransactionID string
+	Date          time.Time
+	Amount        money.Money
+	Balance       money.Money
+}
+
+// RunningBalance returns the balance of the account in the given currency after every posting, in journal order.
+// Debits increase and credits decrease the balance.
+func (l *Ledger) RunningBalance(account string, currency money.Currency) ([]BalanceEntry, error) {
+	if _, err := l.store.Account(account); err != nil {
+		return nil, fmt.Errorf("account %q: %w", account, err)
+	}
+
+	transactions, err := l.store.Transactions()
+	if err != nil {
+		return nil, err
+	}
+
+	balance := money.NewMoney(0, currency)
+	entries := make([]BalanceEntry, 0)
+
+	for _, tx := range transactions {
+		for _, p := range tx.postings {
+			if p.Account != account || p.Amount.Currency() != currency {
+				continue
+			}
+
+			balance, err = balance.Add(p.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			entries = append(entries, BalanceEntry{
+				TransactionID: tx.id,
+				Date:          tx.date,
+				Amount:        p.Amount,
+				Balance:       balance,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// Balance returns the balance of the account in the given currency.
+// Debits increase and credits decrease the balance.
+func (l *Ledger) Balance(account string, currency money.Currency) (money.Money, error) {
+	entries, err := l.RunningBalance(account, currency)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	if len(entries) == 0 {
+		return money.NewMoney(0, currency), nil
+	}
+
+	return entries[len(entries)-1].Balance, nil
+}
+
+// TrialBalanceLine is the balance of a single account in a single currency.
+// Exactly one of Debit and Credit is non-zero unless the account is settled.
+type TrialBalanceLine struct {
+	Account Account
+	Debit   money.Money
+	Credit  money.Money
+}
+
+// TrialBalanceTotal sums all lines of a TrialBalance in a single currency.
+type TrialBalanceTotal struct {
+	Currency money.Currency
+	Debit    money.Money
+	Credit   money.Money
+}
+
+// TrialBalance lists balances of all accounts with their debit and credit totals per currency.
+type TrialBalance struct {
+	Lines  []TrialBalanceLine
+	Totals []TrialBalanceTotal
+}
+
+// Balanced returns true if total debits equal total credits in every currency.
+func (t TrialBalance) Balanced() bool {
+	for _, total := range t.Totals {
+		if eq, err := total.Debit.Equals(total.Credit); err != nil || !eq {
+			return false
+		}
+	}
+	return true
+}
+
+// TrialBalance builds the TrialBalance of all accounts in the order they were opened.
+func (l *Ledger) TrialBalance() (TrialBalance, error) {
+	accounts, err := l.store.Accounts()
+	if err != nil {
+		return TrialBalance{}, err
+	}
+
+	transactions, err := l.store.Transactions()
+	if err != nil {
+		return TrialBalance{}, err
+	}
+
+	balances := make(map[string]map[money.Currency]money.Money)
+	currencies := make(map[money.Currency]struct{})
+
+	for _, tx := range transactions {
+		for _, p := range tx.postings {
+			currency := p.Amount.Currency()
+			currencies[currency] = struct{}{}
+
+			if _, ok := balances[p.Account]; !ok {
+				balances[p.Account] = make(map[money.Currency]money.Money)
+			}
+
+			balance, ok := balances[p.Account][currency]
+			if !ok {
+				balance = money.NewMoney(0, currency)
+			}
+
+			balance, err = balance.Add(p.Amount)
+			if err != nil {
+				return TrialBalance{}, err
+			}
+			balances[p.Account][currency] = balance
+		}
+	}
+
+	ordered := make([]money.Currency, 0, len(currencies))
+	for currency := range currencies {
+		ordered = append(ordered, currency)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Code() < ordered[j].Code()
+	})
+
+	report := TrialBalance{
+		Totals: make([]TrialBalanceTotal, len(ordered)),
+	}
+	totals := make(map[money.Currency]*TrialBalanceTotal)
+	for i, currency := range ordered {
+		report.Totals[i] = TrialBalanceTotal{
+			Currency: currency,
+			Debit:    money.NewMoney(0, currency),
+			Credit:   money.NewMoney(0, currency),
+		}
+		totals[currency] = &report.Totals[i]
+	}
+
+	for _, account := range accounts {
+		for _, currency := range ordered {
+			balance, ok := balances[account.Code][currency]
+			if !ok {
+				continue
+			}
+
+			line := TrialBalanceLine{
+				Account: account,
+				Debit:   money.NewMoney(0, currency),
+				Credit:  money.NewMoney(0, currency),
+			}
+			if balance.Decimal().IsNeg() {
+				line.Credit = money.NewMoneyFromDecimal(balance.Decimal().Neg(), currency)
+			} else {
+				line.Debit = balance
+			}
+
+			total := totals[currency]
+			if total.Debit, err = total.Debit.Add(line.Debit); err != nil {
+				return TrialBalance{}, err
+			}
+			if total.Credit, err = total.Credit.Add(line.Credit); err != nil {
+				return TrialBalance{}, err
+			}
+
+			report.Lines = append(report.Lines, line)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,126 @@
+package ledger_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric/ledger"
+	"github.com/IAmRadek/metric/money"
+	isser "github.com/matryer/is"
+)
+
+func newTestLedger(is *isser.I) *ledger.Ledger {
+	l := ledger.New(ledger.NewMemoryStore())
+
+	is.NoErr(l.OpenAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)))
+	is.NoErr(l.OpenAccount(ledger.NewAccount("sales", "Sales", ledger.Income)))
+	is.NoErr(l.OpenAccount(ledger.NewAccount("rent", "Rent", ledger.Expense)))
+
+	return l
+}
+
+func mustTransaction(is *isser.I, id string, postings ...ledger.Posting) ledger.Transaction {
+	tx, err := ledger.NewTransaction(id, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), id, postings...)
+	is.NoErr(err)
+	return tx
+}
+
+func TestLedger_Post(t *testing.T) {
+	is := isser.New(t)
+	l := newTestLedger(is)
+
+	is.NoErr(l.Post(mustTransaction(is, "tx-1",
+		ledger.Debit("cash", money.NewMoney(10000, money.USD)),
+		ledger.Credit("sales", money.NewMoney(10000, money.USD)),
+	)))
+	is.NoErr(l.Post(mustTransaction(is, "tx-2",
+		ledger.Debit("rent", money.NewMoney(3000, money.USD)),
+		ledger.Credit("cash", money.NewMoney(3000, money.USD)),
+	)))
+
+	err := l.Post(mustTransaction(is, "tx-2",
+		ledger.Debit("rent", money.NewMoney(3000, money.USD)),
+		ledger.Credit("cash", money.NewMoney(3000, money.USD)),
+	))
+	is.True(errors.Is(err, ledger.ErrDuplicateTransaction))
+
+	err = l.Post(mustTransaction(is, "tx-3",
+		ledger.Debit("unknown", money.NewMoney(3000, money.USD)),
+		ledger.Credit("cash", money.NewMoney(3000, money.USD)),
+	))
+	is.True(errors.Is(err, ledger.ErrAccountNotFound))
+
+	err = l.Post(ledger.Transaction{})
+	is.True(errors.Is(err, ledger.ErrEmptyTransaction))
+
+	entries, err := l.RunningBalance("cash", money.USD)
+	is.NoErr(err)
+	is.Equal(len(entries), 2)
+	is.Equal(entries[0].Balance.MinorUnit(), uint64(10000))
+	is.Equal(entries[1].Balance.MinorUnit(), uint64(7000))
+
+	balance, err := l.Balance("cash", money.EUR)
+	is.NoErr(err)
+	is.True(balance.IsZero())
+}
+
+func TestLedger_Reverse(t *testing.T) {
+	is := isser.New(t)
+	l := newTestLedger(is)
+
+	is.NoErr(l.Post(mustTransaction(is, "tx-1",
+		ledger.Debit("cash", money.NewMoney(10000, money.USD)),
+		ledger.Credit("sales", money.NewMoney(10000, money.USD)),
+	)))
+
+	reversal, err := l.Reverse("tx-1", "tx-1-rev", time.Now(), "Refund")
+	is.NoErr(err)
+	is.Equal(reversal.Reverses(), "tx-1")
+
+	_, err = l.Reverse("tx-1", "tx-1-rev-2", time.Now(), "Refund again")
+	is.True(errors.Is(err, ledger.ErrAlreadyReversed))
+
+	_, err = l.Reverse("tx-404", "tx-404-rev", time.Now(), "Missing")
+	is.True(errors.Is(err, ledger.ErrTransactionNotFound))
+
+	balance, err := l.Balance("cash", money.USD)
+	is.NoErr(err)
+	is.True(balance.IsZero())
+
+	journal, err := l.Journal()
+	is.NoErr(err)
+	is.Equal(len(journal), 2)
+}
+
+func TestLedger_TrialBalance(t *testing.T) {
+	is := isser.New(t)
+	l := newTestLedger(is)
+
+	is.NoErr(l.Post(mustTransaction(is, "tx-1",
+		ledger.Debit("cash", money.NewMoney(10000, money.USD)),
+		ledger.Credit("sales", money.NewMoney(10000, money.USD)),
+		ledger.Debit("cash", money.NewMoney(2000, money.EUR)),
+		ledger.Credit("sales", money.NewMoney(2000, money.EUR)),
+	)))
+	is.NoErr(l.Post(mustTransaction(is, "tx-2",
+		ledger.Debit("rent", money.NewMoney(3000, money.USD)),
+		ledger.Credit("cash", money.NewMoney(3000, money.USD)),
+	)))
+
+	report, err := l.TrialBalance()
+	is.NoErr(err)
+	is.True(report.Balanced())
+
+	is.Equal(len(report.Lines), 5)
+	is.Equal(report.Lines[0].Account.Code, "cash")
+	is.Equal(report.Lines[0].Debit.Currency(), money.EUR)
+	is.Equal(report.Lines[1].Debit.MinorUnit(), uint64(7000))
+	is.Equal(report.Lines[3].Account.Code, "sales")
+	is.Equal(report.Lines[3].Credit.MinorUnit(), uint64(10000))
+
+	is.Equal(len(report.Totals), 2)
+	is.Equal(report.Totals[0].Currency, money.EUR)
+	is.Equal(report.Totals[1].Debit.MinorUnit(), uint64(10000))
+	is.Equal(report.Totals[1].Credit.MinorUnit(), uint64(10000))
+}
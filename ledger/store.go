@@ -0,0 +1,104 @@
+package ledger
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrAccountNotFound      = errors.New("account not found")
+	ErrDuplicateAccount     = errors.New("account already exists")
+	ErrTransactionNotFound  = errors.New("transaction not found")
+	ErrDuplicateTransaction = errors.New("transaction already exists")
+)
+
+// Store persists accounts and the journal of transactions.
+// The journal is append-only: transactions are never updated or removed.
+type Store interface {
+	SaveAccount(account Account) error
+	Account(code string) (Account, error)
+	Accounts() ([]Account, error)
+
+	Append(tx Transaction) error
+	Transaction(id string) (Transaction, error)
+	Transactions() ([]Transaction, error)
+}
+
+type memoryStore struct {
+	mu           sync.RWMutex
+	accounts     []Account
+	transactions []Transaction
+}
+
+// NewMemoryStore creates a Store keeping all data in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) SaveAccount(account Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.accounts {
+		if a.Code == account.Code {
+			return ErrDuplicateAccount
+		}
+	}
+
+	s.accounts = append(s.accounts, account)
+	return nil
+}
+
+func (s *memoryStore) Account(code string) (Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, a := range s.accounts {
+		if a.Code == code {
+			return a, nil
+		}
+	}
+
+	return Account{}, ErrAccountNotFound
+}
+
+func (s *memoryStore) Accounts() ([]Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Account(nil), s.accounts...), nil
+}
+
+func (s *memoryStore) Append(tx Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.transactions {
+		if t.id == tx.id {
+			return ErrDuplicateTransaction
+		}
+	}
+
+	s.transactions = append(s.transactions, tx)
+	return nil
+}
+
+func (s *memoryStore) Transaction(id string) (Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, t := range s.transactions {
+		if t.id == id {
+			return t, nil
+		}
+	}
+
+	return Transaction{}, ErrTransactionNotFound
+}
+
+func (s *memoryStore) Transactions() ([]Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Transaction(nil), s.transactions...), nil
+}
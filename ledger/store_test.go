@@ -0,0 +1,154 @@
+package ledger_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric/ledger"
+	"github.com/IAmRadek/metric/money"
+	isser "github.com/matryer/is"
+)
+
+func TestStore(t *testing.T) {
+	tests := []struct {
+		name     string
+		newStore func(is *isser.I, dir string) ledger.Store
+	}{
+		{
+			name: "Memory",
+			newStore: func(_ *isser.I, _ string) ledger.Store {
+				return ledger.NewMemoryStore()
+			},
+		},
+		{
+			name: "File",
+			newStore: func(is *isser.I, dir string) ledger.Store {
+				s, err := ledger.NewFileStore(filepath.Join(dir, "ledger.jsonl"))
+				is.NoErr(err)
+				return s
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			s := tt.newStore(is, t.TempDir())
+
+			is.NoErr(s.SaveAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)))
+			is.True(errors.Is(s.SaveAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)), ledger.ErrDuplicateAccount))
+
+			account, err := s.Account("cash")
+			is.NoErr(err)
+			is.Equal(account.Name, "Cash")
+
+			_, err = s.Account("bank")
+			is.True(errors.Is(err, ledger.ErrAccountNotFound))
+
+			tx, err := ledger.NewTransaction("tx-1", time.Now(), "Transfer",
+				ledger.Debit("cash", money.NewMoney(100, money.USD)),
+				ledger.Credit("cash", money.NewMoney(100, money.USD)),
+			)
+			is.NoErr(err)
+			is.NoErr(s.Append(tx))
+			is.True(errors.Is(s.Append(tx), ledger.ErrDuplicateTransaction))
+
+			stored, err := s.Transaction("tx-1")
+			is.NoErr(err)
+			is.Equal(stored.Description(), "Transfer")
+
+			transactions, err := s.Transactions()
+			is.NoErr(err)
+			is.Equal(len(transactions), 1)
+		})
+	}
+}
+
+func TestFileStore_Reopen(t *testing.T) {
+	is := isser.New(t)
+	path := filepath.Join(t.TempDir(), "ledger.jsonl")
+
+	s, err := ledger.NewFileStore(path)
+	is.NoErr(err)
+
+	is.NoErr(s.SaveAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)))
+	is.NoErr(s.SaveAccount(ledger.NewAccount("sales", "Sales", ledger.Income)))
+
+	tx, err := ledger.NewTransaction("tx-1", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), "Sale",
+		ledger.Debit("cash", money.NewMoney(1999, money.PLN)),
+		ledger.Credit("sales", money.NewMoney(1999, money.PLN)),
+	)
+	is.NoErr(err)
+	is.NoErr(s.Append(tx))
+
+	reopened, err := ledger.NewFileStore(path)
+	is.NoErr(err)
+
+	accounts, err := reopened.Accounts()
+	is.NoErr(err)
+	is.Equal(len(accounts), 2)
+	is.Equal(accounts[1].Type, ledger.Income)
+
+	stored, err := reopened.Transaction("tx-1")
+	is.NoErr(err)
+	is.True(stored.Date().Equal(tx.Date()))
+
+	postings := stored.Postings()
+	is.Equal(postings[0].Amount.Currency(), money.PLN)
+	is.Equal(postings[0].Amount.MinorUnit(), uint64(1999))
+	is.True(!postings[1].IsDebit())
+}
+
+func TestFileStore_UnknownCurrency(t *testing.T) {
+	is := isser.New(t)
+
+	s, err := ledger.NewFileStore(filepath.Join(t.TempDir(), "ledger.jsonl"))
+	is.NoErr(err)
+
+	btc := money.NewNonISOCurrency("Bitcoin", "A decentralized digital currency", "₿", "BTC", 8)
+	tx, err := ledger.NewTransaction("tx-1", time.Now(), "Transfer",
+		ledger.Debit("cash", money.NewMoney(100, btc)),
+		ledger.Credit("cash", money.NewMoney(100, btc)),
+	)
+	is.NoErr(err)
+
+	is.True(errors.Is(s.Append(tx), ledger.ErrUnknownCurrency))
+}
+
+func TestFileStore_FailedWrite(t *testing.T) {
+	is := isser.New(t)
+	dir := filepath.Join(t.TempDir(), "missing")
+
+	// The file cannot be created while its directory is missing.
+	s, err := ledger.NewFileStore(filepath.Join(dir, "ledger.jsonl"))
+	is.NoErr(err)
+
+	is.True(s.SaveAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)) != nil)
+	_, err = s.Account("cash")
+	is.True(errors.Is(err, ledger.ErrAccountNotFound))
+
+	tx, err := ledger.NewTransaction("tx-1", time.Now(), "Transfer",
+		ledger.Debit("cash", money.NewMoney(100, money.USD)),
+		ledger.Credit("cash", money.NewMoney(100, money.USD)),
+	)
+	is.NoErr(err)
+
+	is.True(s.Append(tx) != nil)
+	_, err = s.Transaction("tx-1")
+	is.True(errors.Is(err, ledger.ErrTransactionNotFound))
+
+	// Nothing was kept from the failed writes, so they can be retried.
+	is.NoErr(os.Mkdir(dir, 0o755))
+	is.NoErr(s.SaveAccount(ledger.NewAccount("cash", "Cash", ledger.Asset)))
+	is.NoErr(s.Append(tx))
+
+	reopened, err := ledger.NewFileStore(filepath.Join(dir, "ledger.jsonl"))
+	is.NoErr(err)
+	transactions, err := reopened.Transactions()
+	is.NoErr(err)
+	is.Equal(len(transactions), 1)
+}
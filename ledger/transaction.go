@@ -0,0 +1,147 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/IAmRadek/metric/money"
+)
+
+var (
+	ErrEmptyTransaction = errors.New("transaction has no postings")
+	ErrUnbalanced       = errors.New("transaction is not balanced")
+)
+
+// Posting is a single entry of a Transaction.
+// Positive amounts are debits, negative amounts are credits.
+type Posting struct {
+	Account string
+	Amount  money.Money
+}
+
+// Debit creates a Posting debiting the account with amount.
+func Debit(account string, amount money.Money) Posting {
+	return Posting{
+		Account: account,
+		Amount:  amount,
+	}
+}
+
+// Credit creates a Posting crediting the account with amount.
+func Credit(account string, amount money.Money) Posting {
+	return Posting{
+		Account: account,
+		Amount:  money.NewMoneyFromDecimal(amount.Decimal().Neg(), amount.Currency()),
+	}
+}
+
+// IsDebit returns true if the Posting debits the account.
+func (p Posting) IsDebit() bool {
+	return !p.Amount.Decimal().IsNeg()
+}
+
+func (p Posting) String() string {
+	return fmt.Sprintf("%s %s", p.Account, p.Amount)
+}
+
+// Transaction is an immutable, balanced set of postings.
+type Transaction struct {
+	id          string
+	date        time.Time
+	description string
+	postings    []Posting
+	reverses    string
+}
+
+// NewTransaction creates a Transaction after verifying that postings balance in every currency.
+func NewTransaction(id string, date time.Time, description string, postings ...Posting) (Transaction, error) {
+	tx := Transaction{
+		id:          id,
+		date:        date,
+		description: description,
+		postings:    append([]Posting(nil), postings...),
+	}
+
+	if err := tx.validate(); err != nil {
+		return Transaction{}, err
+	}
+
+	return tx, nil
+}
+
+func (t Transaction) ID() string {
+	return t.id
+}
+
+func (t Transaction) Date() time.Time {
+	return t.date
+}
+
+func (t Transaction) Description() string {
+	return t.description
+}
+
+// Postings returns a copy of the postings of the Transaction.
+func (t Transaction) Postings() []Posting {
+	return append([]Posting(nil), t.postings...)
+}
+
+// Reverses returns the ID of the Transaction reversed by this one or "" if it is not a reversal.
+func (t Transaction) Reverses() string {
+	return t.reverses
+}
+
+// Reversal creates a Transaction undoing the effect of t by negating all of its postings.
+func (t Transaction) Reversal(id string, date time.Time, description string) Transaction {
+	postings := make([]Posting, len(t.postings))
+	for i, p := range t.postings {
+		postings[i] = Posting{
+			Account: p.Account,
+			Amount:  money.NewMoneyFromDecimal(p.Amount.Decimal().Neg(), p.Amount.Currency()),
+		}
+	}
+
+	return Transaction{
+		id:          id,
+		date:        date,
+		description: description,
+		postings:    postings,
+		reverses:    t.id,
+	}
+}
+
+func (t Transaction) validate() error {
+	if len(t.postings) == 0 {
+		return fmt.Errorf("transaction %q: %w", t.id, ErrEmptyTransaction)
+	}
+
+	sums := make(map[money.Currency]money.Money)
+	for _, p := range t.postings {
+		sum, ok := sums[p.Amount.Currency()]
+		if !ok {
+			sums[p.Amount.Currency()] = p.Amount
+			continue
+		}
+
+		sum, err := sum.Add(p.Amount)
+		if err != nil {
+			return fmt.Errorf("transaction %q: %w", t.id, err)
+		}
+		sums[p.Amount.Currency()] = sum
+	}
+
+	codes := make([]string, 0)
+	for currency, sum := range sums {
+		if !sum.IsZero() {
+			codes = append(codes, currency.Code())
+		}
+	}
+	if len(codes) > 0 {
+		sort.Strings(codes)
+		return fmt.Errorf("transaction %q: %w in %v", t.id, ErrUnbalanced, codes)
+	}
+
+	return nil
+}
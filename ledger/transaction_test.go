@@ -0,0 +1,88 @@
+package ledger_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric/ledger"
+	"github.com/IAmRadek/metric/money"
+	isser "github.com/matryer/is"
+)
+
+func TestTransaction(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		postings []ledger.Posting
+		check    func(is *isser.I, tx ledger.Transaction, err error)
+	}{
+		{
+			name: "Balanced",
+			postings: []ledger.Posting{
+				ledger.Debit("cash", money.NewMoney(1000, money.USD)),
+				ledger.Credit("sales", money.NewMoney(1000, money.USD)),
+			},
+			check: func(is *isser.I, tx ledger.Transaction, err error) {
+				is.NoErr(err)
+				is.Equal(tx.ID(), "tx-1")
+				is.Equal(len(tx.Postings()), 2)
+				is.True(tx.Postings()[0].IsDebit())
+				is.True(!tx.Postings()[1].IsDebit())
+			},
+		},
+		{
+			name: "BalancedPerCurrency",
+			postings: []ledger.Posting{
+				ledger.Debit("cash", money.NewMoney(1000, money.USD)),
+				ledger.Credit("sales", money.NewMoney(1000, money.USD)),
+				ledger.Debit("cash", money.NewMoney(500, money.EUR)),
+				ledger.Credit("sales", money.NewMoney(500, money.EUR)),
+			},
+			check: func(is *isser.I, _ ledger.Transaction, err error) {
+				is.NoErr(err)
+			},
+		},
+		{
+			name: "Unbalanced",
+			postings: []ledger.Posting{
+				ledger.Debit("cash", money.NewMoney(1000, money.USD)),
+				ledger.Credit("sales", money.NewMoney(1000, money.EUR)),
+			},
+			check: func(is *isser.I, _ ledger.Transaction, err error) {
+				is.True(errors.Is(err, ledger.ErrUnbalanced))
+			},
+		},
+		{
+			name: "Empty",
+			check: func(is *isser.I, _ ledger.Transaction, err error) {
+				is.True(errors.Is(err, ledger.ErrEmptyTransaction))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			tx, err := ledger.NewTransaction("tx-1", date, "Sale", tt.postings...)
+			tt.check(is, tx, err)
+		})
+	}
+}
+
+func TestTransaction_Reversal(t *testing.T) {
+	is := isser.New(t)
+
+	tx, err := ledger.NewTransaction("tx-1", time.Now(), "Sale",
+		ledger.Debit("cash", money.NewMoney(1000, money.USD)),
+		ledger.Credit("sales", money.NewMoney(1000, money.USD)),
+	)
+	is.NoErr(err)
+
+	reversal := tx.Reversal("tx-2", time.Now(), "Refund")
+	is.Equal(reversal.Reverses(), "tx-1")
+	is.True(!reversal.Postings()[0].IsDebit())
+	is.True(reversal.Postings()[1].IsDebit())
+}
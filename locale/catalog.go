@@ -0,0 +1,221 @@
+package locale
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ErrNoTranslation = errors.New("no translation")
+	ErrUnknownUnit   = errors.New("unknown unit")
+)
+
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// Default is the Catalog of SI and common units in English, German and Polish.
+var Default = NewCatalog()
+
+func init() {
+	if err := Default.LoadJSON(bytes.NewReader(defaultCatalog)); err != nil {
+		panic(fmt.Sprintf("locale: loading default catalog: %v", err))
+	}
+}
+
+// UnitNames holds the localized forms of a unit name, one per CLDR plural category used by the language,
+// e.g. "metr", "metry", "metrów" and "metra" for One, Few, Many and Other in Polish.
+// Missing forms fall back to Other, then to One.
+type UnitNames struct {
+	One   string `json:"one,omitempty"`
+	Few   string `json:"few,omitempty"`
+	Many  string `json:"many,omitempty"`
+	Other string `json:"other,omitempty"`
+
+	// Genitive is the genitive singular, e.g. "metra" in Polish or "Meters" in German. Falls back to One.
+	Genitive string `json:"genitive,omitempty"`
+
+	// Symbol is the localized symbol. Falls back to the symbol of the unit.
+	Symbol string `json:"symbol,omitempty"`
+}
+
+// Form returns the name for the plural category.
+func (n UnitNames) Form(category PluralCategory) string {
+	var form string
+
+	switch category {
+	case One:
+		form = n.One
+	case Few:
+		form = n.Few
+	case Many:
+		form = n.Many
+	}
+
+	if form == "" {
+		form = n.Other
+	}
+	if form == "" {
+		form = n.One
+	}
+
+	return form
+}
+
+// Singular returns the singular name.
+func (n UnitNames) Singular() string {
+	return n.Form(One)
+}
+
+// Plural returns the plural name used for integers other than one, e.g. "meters" or the Polish "metry".
+func (n UnitNames) Plural() string {
+	if n.Few != "" {
+		return n.Few
+	}
+	return n.Form(Other)
+}
+
+// GenitiveSingular returns the genitive singular name.
+func (n UnitNames) GenitiveSingular() string {
+	if n.Genitive != "" {
+		return n.Genitive
+	}
+	return n.Singular()
+}
+
+// Catalog holds the localized names of units. It is safe for concurrent use.
+type Catalog struct {
+	mu    sync.RWMutex
+	names map[Language]map[metric.Metric]UnitNames
+}
+
+// NewCatalog creates an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{
+		names: make(map[Language]map[metric.Metric]UnitNames),
+	}
+}
+
+// Register adds or replaces the localized names of a unit, e.g. of a custom unit.
+func (c *Catalog) Register(lang Language, unit metric.Metric, names UnitNames) error {
+	if _, ok := pluralRules[lang.base()]; !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.names[lang.base()]; !ok {
+		c.names[lang.base()] = make(map[metric.Metric]UnitNames)
+	}
+	c.names[lang.base()][unit] = names
+
+	return nil
+}
+
+// LoadJSON registers the names from a JSON object keyed by language and then by unit name, e.g.
+// {"pl": {"meter": {"one": "metr", "few": "metry", "many": "metrów", "other": "metra"}}}.
+// Units are looked up by name in the SI, Non-SI and IEC systems of units.
+func (c *Catalog) LoadJSON(r io.Reader) error {
+	var catalog map[Language]map[string]UnitNames
+	if err := json.NewDecoder(r).Decode(&catalog); err != nil {
+		return fmt.Errorf("decoding catalog: %w", err)
+	}
+
+	units := make(map[string]metric.Unit)
+	for _, system := range []metric.SystemOfUnits{metric.SISystemOfUnits, metric.NonSISystemOfUnits, metric.IECSystemOfUnits} {
+		for _, unit := range system.Units() {
+			units[unit.Name()] = unit
+		}
+	}
+
+	for lang, entries := range catalog {
+		for name, names := range entries {
+			unit, ok := units[name]
+			if !ok {
+				return fmt.Errorf("%w: %q", ErrUnknownUnit, name)
+			}
+			if err := c.Register(lang, unit, names); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Names returns the localized names of the unit.
+func (c *Catalog) Names(lang Language, unit metric.Metric) (UnitNames, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	names, ok := c.names[lang.base()][unit]
+	if !ok {
+		return UnitNames{}, fmt.Errorf("%w: %s in %q", ErrNoTranslation, unit.Name(), lang)
+	}
+
+	return names, nil
+}
+
+// Name returns the localized name of the unit in the form agreeing with the amount, e.g. "metrów" for 5 in Polish.
+func (c *Catalog) Name(lang Language, unit metric.Metric, amount float64) (string, error) {
+	names, err := c.Names(lang, unit)
+	if err != nil {
+		return "", err
+	}
+
+	category, err := Plural(lang, amount)
+	if err != nil {
+		return "", err
+	}
+
+	return names.Form(category), nil
+}
+
+// Symbol returns the localized symbol of the unit, or its own symbol if it has no localized one.
+func (c *Catalog) Symbol(lang Language, unit metric.Metric) string {
+	names, err := c.Names(lang, unit)
+	if err != nil || names.Symbol == "" {
+		return unit.Symbol()
+	}
+	return names.Symbol
+}
+
+// Format returns the Quantity with the localized decimal separator and unit name, e.g. "1,5 metra" in Polish.
+func (c *Catalog) Format(lang Language, quantity metric.Quantity) (string, error) {
+	names, err := c.Names(lang, quantity.Metric())
+	if err != nil {
+		return "", err
+	}
+
+	number := strconv.FormatFloat(quantity.Amount(), 'f', -1, 64)
+	category, err := plural(lang, number)
+	if err != nil {
+		return "", err
+	}
+
+	return localizeNumber(lang, number) + " " + names.Form(category), nil
+}
+
+// FormatSymbol returns the Quantity with the localized decimal separator and unit symbol, e.g. "1,5 m" in German.
+func (c *Catalog) FormatSymbol(lang Language, quantity metric.Quantity) (string, error) {
+	if _, ok := pluralRules[lang.base()]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	number := strconv.FormatFloat(quantity.Amount(), 'f', -1, 64)
+
+	return localizeNumber(lang, number) + " " + c.Symbol(lang, quantity.Metric()), nil
+}
+
+func localizeNumber(lang Language, number string) string {
+	return strings.Replace(number, ".", decimalSeparators[lang.base()], 1)
+}
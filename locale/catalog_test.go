@@ -0,0 +1,144 @@
+package locale_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/locale"
+	isser "github.com/matryer/is"
+)
+
+func TestCatalogFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     locale.Language
+		quantity metric.Quantity
+		expected string
+	}{
+		{name: "EnglishSingular", lang: locale.English, quantity: metric.NewQuantity(1, metric.Meter), expected: "1 meter"},
+		{name: "EnglishPlural", lang: locale.English, quantity: metric.NewQuantity(12, metric.Meter), expected: "12 meters"},
+		{name: "EnglishFraction", lang: locale.English, quantity: metric.NewQuantity(1.5, metric.Celsius), expected: "1.5 degrees Celsius"},
+		{name: "GermanPlural", lang: locale.German, quantity: metric.NewQuantity(5, metric.Second), expected: "5 Sekunden"},
+		{name: "GermanInvariant", lang: locale.German, quantity: metric.NewQuantity(2.5, metric.Kilogram), expected: "2,5 Kilogramm"},
+		{name: "PolishOne", lang: locale.Polish, quantity: metric.NewQuantity(1, metric.Meter), expected: "1 metr"},
+		{name: "PolishFew", lang: locale.Polish, quantity: metric.NewQuantity(3, metric.Meter), expected: "3 metry"},
+		{name: "PolishMany", lang: locale.Polish, quantity: metric.NewQuantity(5, metric.Meter), expected: "5 metrów"},
+		{name: "PolishFraction", lang: locale.Polish, quantity: metric.NewQuantity(1.5, metric.Meter), expected: "1,5 metra"},
+		{name: "PolishCompound", lang: locale.Polish, quantity: metric.NewQuantity(24, metric.Hour), expected: "24 godziny"},
+		{name: "PolishDerived", lang: locale.Polish, quantity: metric.NewQuantity(12, metric.Area), expected: "12 metrów kwadratowych"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			formatted, err := locale.Default.Format(tt.lang, tt.quantity)
+			is.NoErr(err)
+			is.Equal(formatted, tt.expected)
+		})
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(is *isser.I)
+	}{
+		{
+			name: "Names",
+			check: func(is *isser.I) {
+				names, err := locale.Default.Names(locale.Polish, metric.Second)
+				is.NoErr(err)
+				is.Equal(names.Singular(), "sekunda")
+				is.Equal(names.Plural(), "sekundy")
+				is.Equal(names.Form(locale.Many), "sekund")
+				is.Equal(names.GenitiveSingular(), "sekundy")
+
+				names, err = locale.Default.Names(locale.German, metric.Meter)
+				is.NoErr(err)
+				is.Equal(names.GenitiveSingular(), "Meters")
+
+				names, err = locale.Default.Names(locale.English, metric.Meter)
+				is.NoErr(err)
+				is.Equal(names.Plural(), "meters")
+				is.Equal(names.GenitiveSingular(), "meter")
+			},
+		},
+		{
+			name: "Name",
+			check: func(is *isser.I) {
+				name, err := locale.Default.Name(locale.Polish, metric.Week, 5)
+				is.NoErr(err)
+				is.Equal(name, "tygodni")
+			},
+		},
+		{
+			name: "Symbol",
+			check: func(is *isser.I) {
+				is.Equal(locale.Default.Symbol(locale.Polish, metric.Week), "tydz.")
+				is.Equal(locale.Default.Symbol(locale.German, metric.Week), "Wo.")
+				is.Equal(locale.Default.Symbol(locale.English, metric.Meter), "m")
+
+				formatted, err := locale.Default.FormatSymbol(locale.German, metric.NewQuantity(1.5, metric.Meter))
+				is.NoErr(err)
+				is.Equal(formatted, "1,5 m")
+			},
+		},
+		{
+			name: "NoTranslation",
+			check: func(is *isser.I) {
+				_, err := locale.Default.Format(locale.Polish, metric.NewQuantity(1, metric.Neper))
+				is.True(errors.Is(err, locale.ErrNoTranslation))
+			},
+		},
+		{
+			name: "UnsupportedLanguage",
+			check: func(is *isser.I) {
+				_, err := locale.Default.FormatSymbol("fr", metric.NewQuantity(1, metric.Meter))
+				is.True(errors.Is(err, locale.ErrUnsupportedLanguage))
+
+				err = locale.NewCatalog().Register("fr", metric.Meter, locale.UnitNames{One: "mètre"})
+				is.True(errors.Is(err, locale.ErrUnsupportedLanguage))
+			},
+		},
+		{
+			name: "RegisterCustomUnit",
+			check: func(is *isser.I) {
+				furlong := metric.NewBaseUnit("furlong", "The furlong is 660 feet", "fur", nil)
+
+				catalog := locale.NewCatalog()
+				err := catalog.Register(locale.Polish, furlong, locale.UnitNames{One: "furlong", Few: "furlongi", Many: "furlongów", Other: "furlonga"})
+				is.NoErr(err)
+
+				formatted, err := catalog.Format("pl-PL", metric.NewQuantity(7, furlong))
+				is.NoErr(err)
+				is.Equal(formatted, "7 furlongów")
+			},
+		},
+		{
+			name: "LoadJSON",
+			check: func(is *isser.I) {
+				catalog := locale.NewCatalog()
+				err := catalog.LoadJSON(strings.NewReader(`{"en": {"neper": {"one": "neper", "other": "nepers"}}}`))
+				is.NoErr(err)
+
+				formatted, err := catalog.Format(locale.English, metric.NewQuantity(2, metric.Neper))
+				is.NoErr(err)
+				is.Equal(formatted, "2 nepers")
+
+				err = catalog.LoadJSON(strings.NewReader(`{"en": {"furlong": {"one": "furlong"}}}`))
+				is.True(errors.Is(err, locale.ErrUnknownUnit))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(isser.New(t))
+		})
+	}
+}
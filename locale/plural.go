@@ -0,0 +1,123 @@
+// Package locale provides localized, pluralized names and symbols of metric units.
+// Plural forms are selected with the CLDR plural rules of the language.
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+)
+
+// Language is a BCP 47 language tag, e.g. "pl" or "de-AT". Only the primary language subtag is used.
+type Language string
+
+const (
+	English Language = "en"
+	German  Language = "de"
+	Polish  Language = "pl"
+)
+
+// base returns the primary language subtag, e.g. "de" for "de-AT" or "de_AT".
+func (l Language) base() Language {
+	tag, _, _ := strings.Cut(strings.ReplaceAll(string(l), "_", "-"), "-")
+	return Language(strings.ToLower(tag))
+}
+
+// PluralCategory is a CLDR plural category.
+type PluralCategory int
+
+const (
+	Zero PluralCategory = iota
+	One
+	Two
+	Few
+	Many
+	Other
+)
+
+func (c PluralCategory) String() string {
+	switch c {
+	case Zero:
+		return "zero"
+	case One:
+		return "one"
+	case Two:
+		return "two"
+	case Few:
+		return "few"
+	case Many:
+		return "many"
+	case Other:
+		return "other"
+	default:
+		return fmt.Sprintf("PluralCategory(%d)", int(c))
+	}
+}
+
+// pluralRule selects the category from the CLDR operands: the absolute integer digits i
+// and the number of visible fraction digits v.
+type pluralRule func(i int64, v int) PluralCategory
+
+var pluralRules = map[Language]pluralRule{
+	English: oneOther,
+	German:  oneOther,
+	Polish: func(i int64, v int) PluralCategory {
+		switch {
+		case v != 0:
+			return Other
+		case i == 1:
+			return One
+		case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+			return Few
+		default:
+			return Many
+		}
+	},
+}
+
+func oneOther(i int64, v int) PluralCategory {
+	if i == 1 && v == 0 {
+		return One
+	}
+	return Other
+}
+
+// decimalSeparators lists the decimal separator of each language.
+var decimalSeparators = map[Language]string{
+	English: ".",
+	German:  ",",
+	Polish:  ",",
+}
+
+// Plural returns the CLDR plural category of the number as it would be displayed in its shortest representation,
+// e.g. 1 is One and 1.5 is Other in English, while 5 is Many in Polish.
+func Plural(lang Language, number float64) (PluralCategory, error) {
+	return plural(lang, strconv.FormatFloat(math.Abs(number), 'f', -1, 64))
+}
+
+// plural returns the CLDR plural category of the formatted number with '.' as the decimal separator.
+func plural(lang Language, number string) (PluralCategory, error) {
+	rule, ok := pluralRules[lang.base()]
+	if !ok {
+		return Other, fmt.Errorf("%w: %q", ErrUnsupportedLanguage, lang)
+	}
+
+	integer, fraction, _ := strings.Cut(strings.TrimPrefix(number, "-"), ".")
+
+	i, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		// Integers beyond int64 only matter through their last digits.
+		if len(integer) > 2 {
+			i, _ = strconv.ParseInt(integer[len(integer)-2:], 10, 64)
+			i += 100
+		}
+	}
+
+	return rule(i, len(fraction)), nil
+}
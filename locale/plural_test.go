@@ -0,0 +1,59 @@
+package locale_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric/locale"
+	isser "github.com/matryer/is"
+)
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     locale.Language
+		number   float64
+		expected locale.PluralCategory
+	}{
+		{name: "EnglishOne", lang: locale.English, number: 1, expected: locale.One},
+		{name: "EnglishOther", lang: locale.English, number: 2, expected: locale.Other},
+		{name: "EnglishZero", lang: locale.English, number: 0, expected: locale.Other},
+		{name: "EnglishFraction", lang: locale.English, number: 1.5, expected: locale.Other},
+		{name: "EnglishNegativeOne", lang: locale.English, number: -1, expected: locale.One},
+		{name: "GermanRegion", lang: "de-AT", number: 1, expected: locale.One},
+		{name: "PolishOne", lang: locale.Polish, number: 1, expected: locale.One},
+		{name: "PolishFew", lang: locale.Polish, number: 3, expected: locale.Few},
+		{name: "PolishFewCompound", lang: locale.Polish, number: 22, expected: locale.Few},
+		{name: "PolishManyTeen", lang: locale.Polish, number: 12, expected: locale.Many},
+		{name: "PolishMany", lang: locale.Polish, number: 5, expected: locale.Many},
+		{name: "PolishManyZero", lang: locale.Polish, number: 0, expected: locale.Many},
+		{name: "PolishManyEleven", lang: locale.Polish, number: 111, expected: locale.Many},
+		{name: "PolishFraction", lang: locale.Polish, number: 2.5, expected: locale.Other},
+		{name: "PolishLarge", lang: "pl_PL", number: 1e22, expected: locale.Many},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			category, err := locale.Plural(tt.lang, tt.number)
+			is.NoErr(err)
+			is.Equal(category, tt.expected)
+		})
+	}
+
+	t.Run("Unsupported", func(t *testing.T) {
+		is := isser.New(t)
+
+		_, err := locale.Plural("fr", 1)
+		is.True(errors.Is(err, locale.ErrUnsupportedLanguage))
+	})
+}
+
+func TestPluralCategoryString(t *testing.T) {
+	is := isser.New(t)
+
+	is.Equal(locale.Few.String(), "few")
+	is.Equal(locale.PluralCategory(42).String(), "PluralCategory(42)")
+}
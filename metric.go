@@ -1,5 +1,9 @@
 package metric
 
+import (
+	"sync"
+)
+
 // Metric describes a standard of measurement.
 type Metric interface {
 	// Name returns the name of the metric. For example, "weight".
@@ -53,6 +57,82 @@ type Unit interface {
 	SystemOfUnits() SystemOfUnits
 }
 
+type unitImpl struct {
+	name          string
+	plural        string
+	definition    string
+	symbol        string
+	systemOfUnits SystemOfUnits
+}
+
+// PluralNamer is implemented by units that know the plural of their name, e.g. "meters" for the meter.
+// The '+' flag of the quantity formatter prints it for amounts other than one.
+type PluralNamer interface {
+	PluralName() string
+}
+
+// pluralizable is implemented by the units of this package, which keep the plural given to WithPlural.
+type pluralizable interface {
+	setPlural(plural string)
+}
+
+// WithPlural sets the plural of the unit's name and returns the unit, to be used in the unit's declaration,
+// e.g. WithPlural(NewBaseUnit("foot", …), "feet"). Units without a plural use their name as plural.
+func WithPlural[U Unit](unit U, plural string) U {
+	if p, ok := any(unit).(pluralizable); ok {
+		p.setPlural(plural)
+	}
+	return unit
+}
+
+// NewBaseUnit creates a new Unit that is not derived from other units, e.g., the bit.
+// If the systemOfUnits is not nil, the Unit is added to it.
+func NewBaseUnit(name, definition, symbol string, systemOfUnits SystemOfUnits) Unit {
+	unit := &unitImpl{
+		name:          name,
+		definition:    definition,
+		symbol:        symbol,
+		systemOfUnits: systemOfUnits,
+	}
+
+	if systemOfUnits != nil {
+		systemOfUnits.appendUnit(unit)
+	}
+
+	return unit
+}
+
+func (u *unitImpl) Name() string {
+	return u.name
+}
+
+func (u *unitImpl) PluralName() string {
+	if u.plural == "" {
+		return u.name
+	}
+	return u.plural
+}
+
+func (u *unitImpl) setPlural(plural string) {
+	u.plural = plural
+}
+
+func (u *unitImpl) Definition() string {
+	return u.definition
+}
+
+func (u *unitImpl) Symbol() string {
+	return u.symbol
+}
+
+func (u *unitImpl) SystemOfUnits() SystemOfUnits {
+	return u.systemOfUnits
+}
+
+func (u *unitImpl) String() string {
+	return u.Symbol()
+}
+
 // SystemOfUnits describes a set of related Units defined by a standardization body.
 type SystemOfUnits interface {
 	Name() string
@@ -77,6 +157,70 @@ func NewSystemOfUnits(name, standardizationBody string) SystemOfUnits {
 	}
 }
 
+var (
+	systemsMu sync.RWMutex
+	// registeredSystems lists the systems of units added with RegisterSystemOfUnits.
+	registeredSystems []SystemOfUnits
+)
+
+// RegisterSystemOfUnits makes the units of a system of units defined outside this package, e.g. a generated package
+// of imperial units, visible to LookupUnit, ParseQuantity and FindDerivedUnit. Registering a system again has no effect.
+func RegisterSystemOfUnits(system SystemOfUnits) {
+	systemsMu.Lock()
+	defer systemsMu.Unlock()
+
+	for _, registered := range registeredSystems {
+		if registered == system {
+			return
+		}
+	}
+	registeredSystems = append(registeredSystems, system)
+}
+
+var (
+	aliasesMu sync.RWMutex
+	// aliases maps the symbols and names added with RegisterAlias to their units.
+	aliases = make(map[string][]Unit)
+)
+
+// RegisterAlias makes LookupUnit, and so ParseQuantity, find the unit by other symbols or names, e.g. "mi/h" and
+// "miles per hour" for a mile per hour. Aliases are looked up after the symbols and names of units.
+func RegisterAlias(unit Unit, alias ...string) {
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+
+	for _, a := range alias {
+		if !containsUnit(aliases[a], unit) {
+			aliases[a] = append(aliases[a], unit)
+		}
+	}
+}
+
+// aliased returns the units registered with RegisterAlias under the alias.
+func aliased(alias string) []Unit {
+	aliasesMu.RLock()
+	defer aliasesMu.RUnlock()
+
+	return aliases[alias]
+}
+
+func containsUnit(units []Unit, unit Unit) bool {
+	for _, u := range units {
+		if u == unit {
+			return true
+		}
+	}
+	return false
+}
+
+// SystemsOfUnits returns the SI, Non-SI and IEC systems of units followed by the registered ones.
+func SystemsOfUnits() []SystemOfUnits {
+	systemsMu.RLock()
+	defer systemsMu.RUnlock()
+
+	return append([]SystemOfUnits{SISystemOfUnits, NonSISystemOfUnits, IECSystemOfUnits}, registeredSystems...)
+}
+
 func (s *systemOfUnitsImpl) Name() string {
 	return s.name
 }
@@ -0,0 +1,258 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidDMS = errors.New("invalid degrees-minutes-seconds angle")
+)
+
+var (
+	Degree = newAngleUnit(
+		"degree",
+		"The degree is a unit of plane angle equal to 1/360 of a full turn, or π/180 radians",
+		"°",
+	)
+	Arcminute = newAngleUnit(
+		"arcminute",
+		"The arcminute is a unit of plane angle equal to 1/60 of a degree",
+		"′",
+	)
+	Arcsecond = newAngleUnit(
+		"arcsecond",
+		"The arcsecond is a unit of plane angle equal to 1/60 of an arcminute",
+		"″",
+	)
+	Gradian = newAngleUnit(
+		"gradian",
+		"The gradian is a unit of plane angle equal to 1/400 of a full turn",
+		"gon",
+	)
+	Turn = newAngleUnit(
+		"turn",
+		"The turn is a unit of plane angle equal to one full revolution, or 2π radians",
+		"tr",
+	)
+)
+
+func init() {
+	newLinearConversions(
+		linearUnit{Degree, 1, 1},
+		linearUnit{Radian, 180, math.Pi},
+		linearUnit{Arcminute, 1, 60},
+		linearUnit{Arcsecond, 1, 3_600},
+		linearUnit{Gradian, 9, 10},
+		linearUnit{Turn, 360, 1},
+	)
+}
+
+// newAngleUnit creates a unit of plane angle without a term of the radian, as described on FindDerivedUnit.
+func newAngleUnit(name, definition, symbol string) Unit {
+	return WithPlural(NewBaseUnit(name, definition, symbol, NonSISystemOfUnits), name+"s")
+}
+
+// NormalizeAngle wraps the angle into [0, 1 turn), keeping its unit, e.g. -90° becomes 270°.
+func NormalizeAngle(angle Quantity) (Quantity, error) {
+	full, err := fullTurn(angle)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := math.Mod(angle.Amount(), full)
+	if amount < 0 {
+		amount += full
+	}
+
+	return NewQuantity(amount, angle.Metric()), nil
+}
+
+// NormalizeSignedAngle wraps the angle into (-½ turn, ½ turn], keeping its unit, e.g. 270° becomes -90°.
+func NormalizeSignedAngle(angle Quantity) (Quantity, error) {
+	normalized, err := NormalizeAngle(angle)
+	if err != nil {
+		return nil, err
+	}
+
+	full, err := fullTurn(angle)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := normalized.Amount()
+	if amount > full/2 {
+		amount -= full
+	}
+
+	return NewQuantity(amount, angle.Metric()), nil
+}
+
+// fullTurn returns the amount of one full turn in the unit of the angle.
+func fullTurn(angle Quantity) (float64, error) {
+	unit, ok := angle.Metric().(Unit)
+	if !ok {
+		return 0, ErrMetricIsNotUnit
+	}
+
+	full, err := UnitConverter.Convert(NewQuantity(1, Turn), unit)
+	if err != nil {
+		return 0, ErrIncompatibleMetric{angle.Metric(), Radian}
+	}
+
+	return full.Amount(), nil
+}
+
+// radians returns the amount of the angle in Radians.
+func radians(angle Quantity) (float64, error) {
+	converted, err := UnitConverter.Convert(angle, Radian)
+	if err != nil {
+		return 0, ErrIncompatibleMetric{angle.Metric(), Radian}
+	}
+
+	return converted.Amount(), nil
+}
+
+// Sin returns the sine of the angle.
+func Sin(angle Quantity) (float64, error) {
+	rad, err := radians(angle)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sin(rad), nil
+}
+
+// Cos returns the cosine of the angle.
+func Cos(angle Quantity) (float64, error) {
+	rad, err := radians(angle)
+	if err != nil {
+		return 0, err
+	}
+	return math.Cos(rad), nil
+}
+
+// Tan returns the tangent of the angle.
+func Tan(angle Quantity) (float64, error) {
+	rad, err := radians(angle)
+	if err != nil {
+		return 0, err
+	}
+	return math.Tan(rad), nil
+}
+
+// Asin returns the arcsine of x as an angle in Radians.
+func Asin(x float64) Quantity {
+	return NewQuantity(math.Asin(x), Radian)
+}
+
+// Acos returns the arccosine of x as an angle in Radians.
+func Acos(x float64) Quantity {
+	return NewQuantity(math.Acos(x), Radian)
+}
+
+// Atan returns the arctangent of x as an angle in Radians.
+func Atan(x float64) Quantity {
+	return NewQuantity(math.Atan(x), Radian)
+}
+
+// Atan2 returns the arctangent of y/x as an angle in Radians, using the signs of both to determine the quadrant.
+func Atan2(y, x float64) Quantity {
+	return NewQuantity(math.Atan2(y, x), Radian)
+}
+
+// FormatDMS formats the angle in degrees, minutes and seconds, e.g. 12°34′56.7″.
+// Seconds are rounded to the given number of decimal places.
+func FormatDMS(angle Quantity, decimals int) (string, error) {
+	degrees, err := UnitConverter.Convert(angle, Degree)
+	if err != nil {
+		return "", ErrIncompatibleMetric{angle.Metric(), Degree}
+	}
+
+	sign := ""
+	amount := degrees.Amount()
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	// Round the total number of seconds first so that the rounding carries into minutes and degrees.
+	scale := math.Pow(10, float64(decimals))
+	totalSeconds := math.Round(amount*3_600*scale) / scale
+
+	d := math.Floor(totalSeconds / 3_600)
+	m := math.Floor((totalSeconds - d*3_600) / 60)
+	s := totalSeconds - d*3_600 - m*60
+
+	return fmt.Sprintf("%s%.0f°%.0f′%s″", sign, d, m, strconv.FormatFloat(s, 'f', decimals, 64)), nil
+}
+
+// ParseDMS parses an angle in degrees, minutes and seconds into a Quantity in Degrees.
+// Both typographic (° ′ ″) and ASCII (° ' ") markers are accepted, minutes and seconds are optional,
+// and a leading minus sign or a trailing S or W hemisphere makes the angle negative,
+// e.g. `12°34′56.7″`, `-12° 30'` or `51°28'38"N`. A sign together with a hemisphere, such as `-12°S`,
+// and values that are not finite numbers are rejected.
+func ParseDMS(s string) (Quantity, error) {
+	input := strings.TrimSpace(s)
+	negative := false
+	hemisphere := false
+
+	if input == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDMS, s)
+	}
+
+	switch input[len(input)-1] {
+	case 'N', 'E':
+		hemisphere = true
+		input = strings.TrimSpace(input[:len(input)-1])
+	case 'S', 'W':
+		hemisphere = true
+		negative = true
+		input = strings.TrimSpace(input[:len(input)-1])
+	}
+
+	if strings.HasPrefix(input, "-") {
+		if hemisphere {
+			return nil, fmt.Errorf("%w: %q has both a sign and a hemisphere", ErrInvalidDMS, s)
+		}
+		negative = true
+		input = strings.TrimSpace(input[1:])
+	}
+
+	replacer := strings.NewReplacer("′", "'", "’", "'", "″", `"`, "''", `"`)
+	input = replacer.Replace(input)
+
+	var parts [3]float64
+	markers := []string{"°", "'", `"`}
+	limits := []float64{math.Inf(1), 60, 60}
+	found := false
+
+	for i, marker := range markers {
+		idx := strings.Index(input, marker)
+		if idx < 0 {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(input[:idx]), 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) || value < 0 || value >= limits[i] {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidDMS, s)
+		}
+
+		parts[i] = value
+		input = strings.TrimSpace(input[idx+len(marker):])
+		found = true
+	}
+
+	if !found || input != "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDMS, s)
+	}
+
+	degrees := parts[0] + parts[1]/60 + parts[2]/3_600
+	if negative {
+		degrees = -degrees
+	}
+
+	return NewQuantity(degrees, Degree), nil
+}
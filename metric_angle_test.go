@@ -0,0 +1,158 @@
+package metric_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestAngleConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   metric.Unit
+		expected float64
+	}{
+		{name: "DegreeToRadian", quantity: metric.NewQuantity(180, metric.Degree), target: metric.Radian, expected: math.Pi},
+		{name: "RadianToDegree", quantity: metric.NewQuantity(math.Pi/2, metric.Radian), target: metric.Degree, expected: 90},
+		{name: "DegreeToArcminute", quantity: metric.NewQuantity(1.5, metric.Degree), target: metric.Arcminute, expected: 90},
+		{name: "ArcsecondToDegree", quantity: metric.NewQuantity(5400, metric.Arcsecond), target: metric.Degree, expected: 1.5},
+		{name: "GradianToDegree", quantity: metric.NewQuantity(100, metric.Gradian), target: metric.Degree, expected: 90},
+		{name: "TurnToDegree", quantity: metric.NewQuantity(0.25, metric.Turn), target: metric.Degree, expected: 90},
+		{name: "TurnToRadian", quantity: metric.NewQuantity(1, metric.Turn), target: metric.Radian, expected: 2 * math.Pi},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, tt.target)
+			is.NoErr(err)
+			is.Equal(roundTo(converted.Amount(), 12), roundTo(tt.expected, 12))
+			is.Equal(converted.Metric(), tt.target)
+		})
+	}
+}
+
+func TestNormalizeAngle(t *testing.T) {
+	tests := []struct {
+		name     string
+		angle    metric.Quantity
+		unsigned float64
+		signed   float64
+	}{
+		{name: "Negative", angle: metric.NewQuantity(-90, metric.Degree), unsigned: 270, signed: -90},
+		{name: "MoreThanTurn", angle: metric.NewQuantity(725, metric.Degree), unsigned: 5, signed: 5},
+		{name: "HalfTurn", angle: metric.NewQuantity(180, metric.Degree), unsigned: 180, signed: 180},
+		{name: "MinusHalfTurn", angle: metric.NewQuantity(-180, metric.Degree), unsigned: 180, signed: 180},
+		{name: "FullTurn", angle: metric.NewQuantity(360, metric.Degree), unsigned: 0, signed: 0},
+		{name: "Radians", angle: metric.NewQuantity(3*math.Pi/2, metric.Radian), unsigned: 3 * math.Pi / 2, signed: -math.Pi / 2},
+		{name: "Gradians", angle: metric.NewQuantity(-100, metric.Gradian), unsigned: 300, signed: -100},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			unsigned, err := metric.NormalizeAngle(tt.angle)
+			is.NoErr(err)
+			is.Equal(roundTo(unsigned.Amount(), 12), roundTo(tt.unsigned, 12))
+			is.Equal(unsigned.Metric(), tt.angle.Metric())
+
+			signed, err := metric.NormalizeSignedAngle(tt.angle)
+			is.NoErr(err)
+			is.Equal(roundTo(signed.Amount(), 12), roundTo(tt.signed, 12))
+		})
+	}
+
+	_, err := metric.NormalizeAngle(metric.NewQuantity(1, metric.Meter))
+	is := isser.New(t)
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.Meter, M2: metric.Radian})
+}
+
+func TestTrigonometry(t *testing.T) {
+	is := isser.New(t)
+
+	sin, err := metric.Sin(metric.NewQuantity(30, metric.Degree))
+	is.NoErr(err)
+	is.Equal(roundTo(sin, 12), 0.5)
+
+	cos, err := metric.Cos(metric.NewQuantity(0.5, metric.Turn))
+	is.NoErr(err)
+	is.Equal(roundTo(cos, 12), -1.0)
+
+	tan, err := metric.Tan(metric.NewQuantity(50, metric.Gradian))
+	is.NoErr(err)
+	is.Equal(roundTo(tan, 12), 1.0)
+
+	_, err = metric.Sin(metric.NewQuantity(1, metric.Second))
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.Second, M2: metric.Radian})
+
+	angle, err := metric.UnitConverter.Convert(metric.Atan2(1, -1), metric.Degree)
+	is.NoErr(err)
+	is.Equal(roundTo(angle.Amount(), 12), 135.0)
+
+	is.Equal(metric.Asin(1).Amount(), math.Pi/2)
+	is.Equal(metric.Acos(1).Amount(), 0.0)
+	is.Equal(metric.Atan(0).Metric(), metric.Radian)
+}
+
+func TestDMS(t *testing.T) {
+	is := isser.New(t)
+
+	formatted, err := metric.FormatDMS(metric.NewQuantity(12.5825, metric.Degree), 1)
+	is.NoErr(err)
+	is.Equal(formatted, "12°34′57.0″")
+
+	formatted, err = metric.FormatDMS(metric.NewQuantity(-0.5, metric.Degree), 0)
+	is.NoErr(err)
+	is.Equal(formatted, "-0°30′0″")
+
+	formatted, err = metric.FormatDMS(metric.NewQuantity(29.99999999, metric.Degree), 2)
+	is.NoErr(err)
+	is.Equal(formatted, "30°0′0.00″")
+
+	formatted, err = metric.FormatDMS(metric.NewQuantity(math.Pi, metric.Radian), 0)
+	is.NoErr(err)
+	is.Equal(formatted, "180°0′0″")
+
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{input: `12°34′57″`, expected: 12.5825},
+		{input: `12°34'57"`, expected: 12.5825},
+		{input: `-12° 30'`, expected: -12.5},
+		{input: `51°28'38"N`, expected: 51.477222},
+		{input: `0°7'39"W`, expected: -0.1275},
+		{input: `45°`, expected: 45},
+		{input: `30'`, expected: 0.5},
+	}
+
+	for _, tt := range tests {
+		parsed, err := metric.ParseDMS(tt.input)
+		is.NoErr(err)
+		is.Equal(roundTo(parsed.Amount(), 6), tt.expected)
+		is.Equal(parsed.Metric(), metric.Degree)
+	}
+
+	for _, input := range []string{"", "12", `12°61'`, `abc°`, `12°30'x`, `NaN°`, `Inf°`, `1e400°`, `12°NaN'`, `-12°30'S`, `-12°W`, `-12°N`} {
+		_, err := metric.ParseDMS(input)
+		is.True(errors.Is(err, metric.ErrInvalidDMS))
+	}
+}
+
+func TestAngleUnitsHideNoScale(t *testing.T) {
+	is := isser.New(t)
+
+	unit, ok := metric.FindDerivedUnit(metric.NewDerivedUnitTerm(metric.Radian, 1))
+	is.True(!ok || unit != metric.Degree)
+
+	_, derived := metric.Degree.(metric.DerivedUnit)
+	is.True(!derived)
+}
@@ -2,6 +2,9 @@ package metric
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // DerivedUnit represents a derived unit composed of terms.
@@ -14,6 +17,7 @@ type DerivedUnit interface {
 
 type derivedUnitImpl struct {
 	name          string
+	plural        string
 	definition    string
 	symbol        string
 	systemOfUnits SystemOfUnits
@@ -44,6 +48,17 @@ func (d *derivedUnitImpl) Name() string {
 	return d.name
 }
 
+func (d *derivedUnitImpl) PluralName() string {
+	if d.plural == "" {
+		return d.name
+	}
+	return d.plural
+}
+
+func (d *derivedUnitImpl) setPlural(plural string) {
+	d.plural = plural
+}
+
 func (d *derivedUnitImpl) String() string {
 	return d.Symbol()
 }
@@ -108,3 +123,312 @@ func (d *derivedUnitTermImpl) Exponent() int {
 func (d *derivedUnitTermImpl) Metric() Metric {
 	return d.metric
 }
+
+// FindDerivedUnit looks up a DerivedUnit with the given terms, in any order, in the systems of units of SystemsOfUnits.
+// Repeated units are combined, e.g. m·m matches m², and terms that cancel out match no unit, since units without terms,
+// such as One and Radian, cannot be told apart by their terms.
+//
+// Terms do not record a scale, so units that are multiples of another unit, such as the minute, the kilobyte or
+// the degree, are declared without terms: a term of the second would make the minute match the second.
+// Conversions relate such units to the unit they are a multiple of.
+func FindDerivedUnit(terms ...DerivedUnitTerm) (DerivedUnit, bool) {
+	want := exponentsOf(terms)
+	if len(want) == 0 {
+		return nil, false
+	}
+
+	for _, system := range SystemsOfUnits() {
+		for _, unit := range system.Units() {
+			du, ok := unit.(DerivedUnit)
+			if !ok || len(du.Terms()) == 0 {
+				continue
+			}
+
+			if sameExponents(exponentsOf(du.Terms()), want) {
+				return du, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// exponentsOf returns the exponent of every Metric of the terms, without the metrics whose exponents cancel out.
+func exponentsOf(terms []DerivedUnitTerm) map[Metric]int {
+	exponents := make(map[Metric]int, len(terms))
+	for _, term := range terms {
+		exponents[term.Metric()] += term.Exponent()
+	}
+	for metric, exponent := range exponents {
+		if exponent == 0 {
+			delete(exponents, metric)
+		}
+	}
+	return exponents
+}
+
+func sameExponents(a, b map[Metric]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for metric, exponent := range a {
+		if b[metric] != exponent {
+			return false
+		}
+	}
+	return true
+}
+
+var superscriptReplacer = strings.NewReplacer(
+	"-", "⁻", "0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴", "5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+)
+
+// Superscript returns the exponent in superscript digits, e.g. "⁻¹" for -1.
+func Superscript(exponent int) string {
+	return superscriptReplacer.Replace(strconv.Itoa(exponent))
+}
+
+// SymbolOf returns the symbol the terms have in the style of Quantity.MultiplyBy and Quantity.DivideBy, e.g. "kg*m/s²".
+// A denominator of several terms is parenthesized, e.g. "g/(kg*d)".
+func SymbolOf(terms ...DerivedUnitTerm) string {
+	var numerator, denominator []string
+
+	for _, term := range terms {
+		symbol := term.Metric().Symbol()
+		exponent := term.Exponent()
+		if exponent < 0 {
+			exponent = -exponent
+		}
+		if exponent != 1 {
+			symbol += Superscript(exponent)
+		}
+
+		if term.Exponent() < 0 {
+			denominator = append(denominator, symbol)
+		} else {
+			numerator = append(numerator, symbol)
+		}
+	}
+
+	symbol := strings.Join(numerator, "*")
+	if symbol == "" {
+		symbol = "1"
+	}
+	switch len(denominator) {
+	case 0:
+	case 1:
+		symbol += "/" + denominator[0]
+	default:
+		symbol += "/(" + strings.Join(denominator, "*") + ")"
+	}
+
+	return symbol
+}
+
+// ExpandTerms returns the terms of the unit raised to the exponent. Derived units that are products and quotients of
+// their terms, such as Speed or the units of Quantity.MultiplyBy, are expanded, unlike named units such as Watt.
+// Repeated units are combined and dimensionless ones dropped.
+func ExpandTerms(unit Metric, exponent int) []DerivedUnitTerm {
+	var result []DerivedUnitTerm
+	index := make(map[Metric]int)
+
+	var expand func(unit Metric, exponent int)
+	expand = func(unit Metric, exponent int) {
+		if unit == One || exponent == 0 {
+			return
+		}
+
+		if derived, ok := unit.(DerivedUnit); ok && isProduct(derived) {
+			for _, term := range derived.Terms() {
+				expand(term.Metric(), term.Exponent()*exponent)
+			}
+			return
+		}
+
+		if i, ok := index[unit]; ok {
+			result[i] = NewDerivedUnitTerm(unit, result[i].Exponent()+exponent)
+			return
+		}
+		index[unit] = len(result)
+		result = append(result, NewDerivedUnitTerm(unit, exponent))
+	}
+	expand(unit, exponent)
+
+	combined := result[:0]
+	for _, term := range result {
+		if term.Exponent() != 0 {
+			combined = append(combined, term)
+		}
+	}
+
+	return combined
+}
+
+// isProduct returns true if the derived unit is the product of its terms written out, e.g. "m/s", "m*s" or "kg·m²/s²",
+// that is if its symbol reads as its own terms. Named units such as Watt are not.
+func isProduct(unit DerivedUnit) bool {
+	if len(unit.Terms()) == 0 {
+		return false
+	}
+
+	metrics := make([]Metric, len(unit.Terms()))
+	for i, term := range unit.Terms() {
+		metrics[i] = term.Metric()
+	}
+
+	terms, ok := readTerms(unit.Symbol(), metrics)
+	return ok && sameExponents(exponentsOf(terms), exponentsOf(unit.Terms()))
+}
+
+// readTerms reads the symbol as a product of symbols of the metrics with optional exponents, as written by SymbolOf,
+// e.g. "kg*m/s²" or "g/(kg*d)", also accepting "·" between the factors and exponents such as "^-1".
+// It returns false if the symbol is not such a product.
+func readTerms(symbol string, metrics []Metric) ([]DerivedUnitTerm, bool) {
+	var terms []DerivedUnitTerm
+	sign, grouped, factor := 1, false, true
+
+	// The numerator of a quotient of terms with negative exponents only is "1", e.g. "1/s".
+	if strings.HasPrefix(symbol, "1/") {
+		symbol, factor = symbol[1:], false
+	}
+
+	for symbol != "" {
+		switch {
+		case !factor && (symbol[0] == '*' || strings.HasPrefix(symbol, "·")):
+			symbol = strings.TrimPrefix(strings.TrimPrefix(symbol, "*"), "·")
+			if !grouped {
+				sign = 1
+			}
+			factor = true
+		case !factor && symbol[0] == '/' && !grouped:
+			symbol = symbol[1:]
+			sign = -1
+			if strings.HasPrefix(symbol, "(") {
+				symbol = symbol[1:]
+				grouped = true
+			}
+			factor = true
+		case !factor && symbol[0] == ')' && grouped:
+			symbol = symbol[1:]
+			grouped = false
+		case factor:
+			metric, n := longestSymbol(symbol, metrics)
+			if metric == nil {
+				return nil, false
+			}
+			exponent, m := readExponent(symbol[n:])
+			terms = append(terms, NewDerivedUnitTerm(metric, sign*exponent))
+			symbol = symbol[n+m:]
+			factor = false
+		default:
+			return nil, false
+		}
+	}
+
+	return terms, !factor && !grouped
+}
+
+// longestSymbol returns the metric with the longest symbol at the start of s and the length of that symbol.
+func longestSymbol(s string, metrics []Metric) (Metric, int) {
+	var found Metric
+	for _, metric := range metrics {
+		symbol := metric.Symbol()
+		if symbol != "" && strings.HasPrefix(s, symbol) && (found == nil || len(symbol) > len(found.Symbol())) {
+			found = metric
+		}
+	}
+	if found == nil {
+		return nil, 0
+	}
+	return found, len(found.Symbol())
+}
+
+// readExponent reads an exponent such as "²", "⁻¹" or "^-1" at the start of s, returning 1 if there is none,
+// and the length of the exponent.
+func readExponent(s string) (int, int) {
+	var digits strings.Builder
+	n := 0
+
+	if strings.HasPrefix(s, "^") {
+		n = 1
+		for n < len(s) && (s[n] >= '0' && s[n] <= '9' || n == 1 && s[n] == '-') {
+			digits.WriteByte(s[n])
+			n++
+		}
+	} else {
+		for _, r := range s {
+			digit, ok := superscripts[r]
+			if !ok {
+				break
+			}
+			digits.WriteRune(digit)
+			n += len(string(r))
+		}
+	}
+
+	exponent, err := strconv.Atoi(digits.String())
+	if err != nil {
+		return 1, 0
+	}
+	return exponent, n
+}
+
+var (
+	// The units created by UnitOf for terms without a registered DerivedUnit, keyed by termsKey,
+	// so that the same terms give the same unit.
+	createdUnitsMu sync.Mutex
+	createdUnits   = make(map[string]DerivedUnit)
+)
+
+// UnitOf returns the Unit with the given terms: the unit of a single term with the exponent 1, One for no terms,
+// the registered DerivedUnit found by FindDerivedUnit, or a DerivedUnit named after SymbolOf created on first use.
+func UnitOf(terms ...DerivedUnitTerm) Unit {
+	switch {
+	case len(terms) == 0:
+		return One
+	case len(terms) == 1 && terms[0].Exponent() == 1:
+		if unit, ok := terms[0].Metric().(Unit); ok {
+			return unit
+		}
+	}
+
+	if unit, ok := FindDerivedUnit(terms...); ok {
+		return unit
+	}
+
+	// Registered units list the terms with positive exponents first, e.g. Speed.
+	ordered := make([]DerivedUnitTerm, 0, len(terms))
+	for _, term := range terms {
+		if term.Exponent() > 0 {
+			ordered = append(ordered, term)
+		}
+	}
+	for _, term := range terms {
+		if term.Exponent() < 0 {
+			ordered = append(ordered, term)
+		}
+	}
+
+	key := termsKey(ordered)
+
+	createdUnitsMu.Lock()
+	defer createdUnitsMu.Unlock()
+
+	if unit, ok := createdUnits[key]; ok {
+		return unit
+	}
+
+	symbol := SymbolOf(ordered...)
+	unit := NewDerivedUnit(symbol, fmt.Sprintf("The unit %s derived from its terms", symbol), symbol, nil, ordered...)
+	createdUnits[key] = unit
+
+	return unit
+}
+
+func termsKey(terms []DerivedUnitTerm) string {
+	var b strings.Builder
+	for _, term := range terms {
+		fmt.Fprintf(&b, "%p^%d;", term.Metric(), term.Exponent())
+	}
+	return b.String()
+}
@@ -52,4 +52,88 @@ func TestDerivedUnitTerm(t *testing.T) {
 	is.Equal(term.String(), "m^2")
 	is.Equal(term.Exponent(), 2)
 	is.Equal(term.Metric(), metric.Meter)
-}
\ No newline at end of file
+}
+
+func TestFindDerivedUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		terms    []metric.DerivedUnitTerm
+		expected metric.DerivedUnit
+	}{
+		{
+			name:     "InOrder",
+			terms:    []metric.DerivedUnitTerm{metric.NewDerivedUnitTerm(metric.Meter, 1), metric.NewDerivedUnitTerm(metric.Second, -1)},
+			expected: metric.Speed,
+		},
+		{
+			name:     "AnyOrder",
+			terms:    []metric.DerivedUnitTerm{metric.NewDerivedUnitTerm(metric.Kilogram, 1), metric.NewDerivedUnitTerm(metric.Meter, 2), metric.NewDerivedUnitTerm(metric.Second, -2)},
+			expected: metric.Joule,
+		},
+		{
+			name:     "RepeatedTerms",
+			terms:    []metric.DerivedUnitTerm{metric.NewDerivedUnitTerm(metric.Meter, 1), metric.NewDerivedUnitTerm(metric.Meter, 1)},
+			expected: metric.Area,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			unit, ok := metric.FindDerivedUnit(tt.terms...)
+			is.True(ok)
+			is.Equal(unit, tt.expected)
+		})
+	}
+
+	t.Run("NoTerms", func(t *testing.T) {
+		is := isser.New(t)
+
+		_, ok := metric.FindDerivedUnit()
+		is.True(!ok)
+
+		_, ok = metric.FindDerivedUnit(metric.NewDerivedUnitTerm(metric.Meter, 1), metric.NewDerivedUnitTerm(metric.Meter, -1))
+		is.True(!ok)
+	})
+}
+
+func TestUnitOf(t *testing.T) {
+	is := isser.New(t)
+
+	is.Equal(metric.UnitOf(), metric.One)
+	is.Equal(metric.UnitOf(metric.NewDerivedUnitTerm(metric.Meter, 1)), metric.Meter)
+	is.Equal(metric.UnitOf(metric.NewDerivedUnitTerm(metric.Second, -1), metric.NewDerivedUnitTerm(metric.Meter, 1)), metric.Speed)
+
+	created := metric.UnitOf(metric.NewDerivedUnitTerm(metric.Second, -2), metric.NewDerivedUnitTerm(metric.Kilogram, 1))
+	is.Equal(created.Symbol(), "kg/s²")
+	is.Equal(metric.UnitOf(metric.NewDerivedUnitTerm(metric.Kilogram, 1), metric.NewDerivedUnitTerm(metric.Second, -2)), created)
+
+	is.Equal(metric.SymbolOf(), "1")
+	is.Equal(metric.SymbolOf(metric.NewDerivedUnitTerm(metric.Second, -1)), "1/s")
+	is.Equal(metric.Superscript(-12), "⁻¹²")
+
+	terms := metric.ExpandTerms(metric.MultiplyUnits(metric.Speed, metric.Second), 2)
+	is.Equal(len(terms), 1)
+	is.Equal(terms[0].Metric(), metric.Meter)
+	is.Equal(terms[0].Exponent(), 2)
+}
+
+func TestSymbolOfDenominator(t *testing.T) {
+	is := isser.New(t)
+
+	terms := []metric.DerivedUnitTerm{
+		metric.NewDerivedUnitTerm(metric.Gram, 1),
+		metric.NewDerivedUnitTerm(metric.Kilogram, -1),
+		metric.NewDerivedUnitTerm(metric.Day, -1),
+	}
+	is.Equal(metric.SymbolOf(terms...), "g/(kg*d)")
+
+	// A product of terms is expanded whatever the way its symbol is written, unlike a named unit.
+	is.Equal(len(metric.ExpandTerms(metric.UnitOf(terms...), 1)), 3)
+	torque := metric.NewDerivedUnit("newton metre", "", "N·m", nil,
+		metric.NewDerivedUnitTerm(metric.Newton, 1), metric.NewDerivedUnitTerm(metric.Meter, 1))
+	is.Equal(len(metric.ExpandTerms(torque, 1)), 2)
+	is.Equal(len(metric.ExpandTerms(metric.Watt, 1)), 1)
+}
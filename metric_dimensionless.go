@@ -0,0 +1,115 @@
+package metric
+
+import "math"
+
+// Dimensionless units express ratios of two quantities of the same kind. They convert to each other, and to
+// DerivedUnits whose terms cancel out, e.g. m/m, through their ratios to One.
+var (
+	One = NewDerivedUnit(
+		"one",
+		"The unit one is the neutral element of any system of units; it is the unit of quantities of dimension one, e.g. ratios of two lengths",
+		"1",
+		NonSISystemOfUnits,
+	)
+	Percent = NewDerivedUnit(
+		"percent",
+		"The percent is a number expressed as a fraction of 100",
+		"%",
+		NonSISystemOfUnits,
+	)
+	Permille = NewDerivedUnit(
+		"permille",
+		"The permille is a number expressed as a fraction of 1000",
+		"‰",
+		NonSISystemOfUnits,
+	)
+	BasisPoint = WithPlural(NewDerivedUnit(
+		"basis point",
+		"The basis point is one hundredth of one percent, commonly used for interest rates and financial percentages",
+		"bp",
+		NonSISystemOfUnits,
+	), "basis points")
+	PartsPerMillion = NewDerivedUnit(
+		"parts per million",
+		"The part per million is a number expressed as a fraction of one million",
+		"ppm",
+		NonSISystemOfUnits,
+	)
+	PartsPerBillion = NewDerivedUnit(
+		"parts per billion",
+		"The part per billion is a number expressed as a fraction of one billion",
+		"ppb",
+		NonSISystemOfUnits,
+	)
+)
+
+// ratios describes the units of dimension one as exact fractions of One.
+var ratios = []linearUnit{
+	{One, 1, 1},
+	{Percent, 1, 100},
+	{Permille, 1, 1_000},
+	{BasisPoint, 1, 10_000},
+	{PartsPerMillion, 1, 1_000_000},
+	{PartsPerBillion, 1, 1_000_000_000},
+}
+
+func init() {
+	newLinearConversions(ratios...)
+}
+
+// IsDimensionless returns true if the Metric is one of the ratio units, e.g. Percent, or a DerivedUnit whose terms
+// cancel out or are ratio units themselves, e.g. m/m. Units without terms such as the Radian are not dimensionless.
+func IsDimensionless(metric Metric) bool {
+	_, ok := ratioOf(metric)
+	return ok
+}
+
+// ratioOf returns the size of a dimensionless Metric in One, multiplying the ratios of the terms that do not cancel out.
+func ratioOf(metric Metric) (linearUnit, bool) {
+	for _, ratio := range ratios {
+		if ratio.unit == metric {
+			return ratio, true
+		}
+	}
+
+	du, ok := metric.(DerivedUnit)
+	if !ok || len(du.Terms()) == 0 {
+		return linearUnit{}, false
+	}
+
+	result := linearUnit{du, 1, 1}
+	for term, exponent := range exponentsOf(du.Terms()) {
+		ratio, ok := ratioOf(term)
+		if !ok {
+			return linearUnit{}, false
+		}
+
+		numerator, denominator := ratio.numerator, ratio.denominator
+		if exponent < 0 {
+			numerator, denominator = denominator, numerator
+		}
+		power := math.Abs(float64(exponent))
+		result.numerator *= math.Pow(numerator, power)
+		result.denominator *= math.Pow(denominator, power)
+	}
+
+	return result, true
+}
+
+// dimensionlessConversion converts between dimensionless units through their ratios, e.g. m/m to Percent.
+func dimensionlessConversion(sourceUnit, targetUnit Unit) (StandardConversion, bool) {
+	source, sourceOK := ratioOf(sourceUnit)
+	target, targetOK := ratioOf(targetUnit)
+	if !sourceOK || !targetOK {
+		return StandardConversion{}, false
+	}
+
+	return StandardConversion{
+		conversionFn: func(quantity Quantity) (Quantity, error) {
+			amount := quantity.Amount() * (source.numerator * target.denominator) / (source.denominator * target.numerator)
+			return NewQuantity(amount, targetUnit), nil
+		},
+		sourceUnit: sourceUnit,
+		targetUnit: targetUnit,
+	}, true
+}
@@ -0,0 +1,68 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestDimensionlessConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   metric.Unit
+		expected float64
+	}{
+		{name: "PercentToOne", quantity: metric.NewQuantity(23, metric.Percent), target: metric.One, expected: 0.23},
+		{name: "OneToPercent", quantity: metric.NewQuantity(0.5, metric.One), target: metric.Percent, expected: 50},
+		{name: "PercentToBasisPoint", quantity: metric.NewQuantity(1.25, metric.Percent), target: metric.BasisPoint, expected: 125},
+		{name: "BasisPointToPercent", quantity: metric.NewQuantity(25, metric.BasisPoint), target: metric.Percent, expected: 0.25},
+		{name: "PermilleToPercent", quantity: metric.NewQuantity(5, metric.Permille), target: metric.Percent, expected: 0.5},
+		{name: "PPMToPPB", quantity: metric.NewQuantity(3, metric.PartsPerMillion), target: metric.PartsPerBillion, expected: 3000},
+		{name: "PPBToPercent", quantity: metric.NewQuantity(10_000_000, metric.PartsPerBillion), target: metric.Percent, expected: 1},
+		{name: "Identity", quantity: metric.NewQuantity(7, metric.Percent), target: metric.Percent, expected: 7},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, tt.target)
+			is.NoErr(err)
+			is.Equal(converted.Amount(), tt.expected)
+			is.Equal(converted.Metric(), tt.target)
+		})
+	}
+}
+
+func TestIsDimensionless(t *testing.T) {
+	is := isser.New(t)
+
+	ratio, err := metric.NewQuantity(3, metric.Meter).DivideBy(metric.NewQuantity(2, metric.Meter))
+	is.NoErr(err)
+
+	is.True(metric.IsDimensionless(metric.Percent))
+	is.True(metric.IsDimensionless(metric.One))
+	is.True(metric.IsDimensionless(ratio.Metric()))
+	is.True(!metric.IsDimensionless(metric.Meter))
+	is.True(!metric.IsDimensionless(metric.Speed))
+	is.True(!metric.IsDimensionless(metric.Radian))
+	is.True(!metric.IsDimensionless(metric.Steradian))
+	is.True(metric.IsDimensionless(metric.UnitOf(metric.NewDerivedUnitTerm(metric.Percent, 2))))
+
+	percents, err := metric.UnitConverter.Convert(ratio, metric.Percent)
+	is.NoErr(err)
+	is.Equal(percents.Amount(), 150.0)
+
+	squared, err := metric.UnitConverter.Convert(metric.NewQuantity(50, metric.UnitOf(metric.NewDerivedUnitTerm(metric.Percent, 2))), metric.One)
+	is.NoErr(err)
+	is.Equal(squared.Amount(), 0.005)
+
+	_, err = metric.UnitConverter.Convert(metric.NewQuantity(1, metric.Radian), metric.One)
+	is.True(errors.Is(err, metric.ErrNoConversion))
+
+	is.Equal(metric.Percent.SystemOfUnits(), metric.NonSISystemOfUnits)
+}
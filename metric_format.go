@@ -0,0 +1,136 @@
+package metric
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric/internal/fmtstate"
+)
+
+// Format implements fmt.Formatter for the quantities created by NewQuantity.
+//
+// The %v and %s verbs print the amount in its shortest representation, while %f, %e and %g (and their upper case forms)
+// format it as they would a float64, honoring the precision. The width pads the whole output.
+// The '+' flag prints the unit name instead of its symbol, in its plural form when the amount is not one, e.g. "12 meters".
+// The '#' flag replaces Unicode symbols with ASCII, e.g. "m^2" for "m²" and "u" for "µ".
+func (q *quantityImpl) Format(f fmt.State, verb rune) {
+	amount, ok := formatAmount(f, verb, q.amount)
+	if !ok {
+		fmt.Fprintf(f, "%%!%c(%s)", verb, q.String())
+		return
+	}
+
+	fmtstate.Pad(f, amount+" "+unitLabel(f, q.metric, q.amount))
+}
+
+// Format implements fmt.Formatter like the quantities created by NewQuantity, additionally printing the uncertainty.
+// The %v and %s verbs print the "12.30 ± 0.05 m" notation, rounding the amount to the uncertainty.
+func (q *measuredQuantityImpl) Format(f fmt.State, verb rune) {
+	if verb == 'v' || verb == 's' {
+		value, uncertainty, _ := q.formatParts()
+		if uncertainty == "" {
+			fmtstate.Pad(f, value+" "+unitLabel(f, q.metric, q.amount))
+			return
+		}
+		fmtstate.Pad(f, value+plusMinus(f)+uncertainty+" "+unitLabel(f, q.metric, q.amount))
+		return
+	}
+
+	amount, ok := formatAmount(f, verb, q.amount)
+	if !ok {
+		fmt.Fprintf(f, "%%!%c(%s)", verb, q.String())
+		return
+	}
+	uncertainty, _ := formatAmount(f, verb, q.uncertainty)
+
+	fmtstate.Pad(f, amount+plusMinus(f)+uncertainty+" "+unitLabel(f, q.metric, q.amount))
+}
+
+// formatAmount formats the amount according to the verb and precision, ignoring the flags handled by unitLabel.
+func formatAmount(f fmt.State, verb rune, amount float64) (string, bool) {
+	switch verb {
+	case 'v', 's':
+		verb = 'v'
+		if _, ok := f.Precision(); ok {
+			verb = 'g'
+		}
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+	default:
+		return "", false
+	}
+
+	format := "%"
+	if precision, ok := f.Precision(); ok {
+		format += "." + strconv.Itoa(precision)
+	}
+
+	return fmt.Sprintf(format+string(verb), amount), true
+}
+
+// unitLabel returns the symbol of the Metric, its name with the '+' flag, or its ASCII symbol with the '#' flag.
+func unitLabel(f fmt.State, metric Metric, amount float64) string {
+	if f.Flag('+') {
+		if amount == 1 || amount == -1 {
+			return metric.Name()
+		}
+		return pluralName(metric)
+	}
+
+	if f.Flag('#') {
+		return ASCIISymbol(metric.Symbol())
+	}
+
+	return metric.Symbol()
+}
+
+func plusMinus(f fmt.State) string {
+	if f.Flag('#') {
+		return " +/- "
+	}
+	return " ± "
+}
+
+// pluralName returns the plural of the Metric's name if it is a PluralNamer, and the name itself otherwise.
+func pluralName(metric Metric) string {
+	if namer, ok := metric.(PluralNamer); ok {
+		return namer.PluralName()
+	}
+	return metric.Name()
+}
+
+var asciiSymbols = strings.NewReplacer(
+	"µ", "u",
+	"°", "deg",
+	"′", "'",
+	"″", `"`,
+	"‰", "permille",
+	"Ω", "Ohm",
+	"·", "*",
+)
+
+var superscripts = map[rune]rune{
+	'⁰': '0', '¹': '1', '²': '2', '³': '3', '⁴': '4', '⁵': '5', '⁶': '6', '⁷': '7', '⁸': '8', '⁹': '9', '⁻': '-',
+}
+
+// ASCIISymbol returns the symbol with Unicode characters replaced by ASCII, e.g. "m^2" for "m²" and "us" for "µs".
+func ASCIISymbol(symbol string) string {
+	var b strings.Builder
+	inExponent := false
+
+	for _, r := range asciiSymbols.Replace(symbol) {
+		digit, ok := superscripts[r]
+		if ok && !inExponent {
+			b.WriteRune('^')
+		}
+		inExponent = ok
+
+		if ok {
+			b.WriteRune(digit)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package metric_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestQuantityFormat(t *testing.T) {
+	kilometer, err := metric.Prefixed(metric.Kilo, metric.Meter)
+	isser.New(t).NoErr(err)
+
+	foot := metric.WithPlural(metric.NewBaseUnit("foot", "A unit with an irregular plural", "ft", nil), "feet")
+
+	tests := []struct {
+		name     string
+		format   string
+		quantity metric.Quantity
+		expected string
+	}{
+		{name: "Value", format: "%v", quantity: metric.NewQuantity(12.5, metric.Meter), expected: "12.5 m"},
+		{name: "String", format: "%s", quantity: metric.NewQuantity(12.5, metric.Meter), expected: "12.5 m"},
+		{name: "Fixed", format: "%.2f", quantity: metric.NewQuantity(3.14159, metric.Meter), expected: "3.14 m"},
+		{name: "Exponent", format: "%.3e", quantity: metric.NewQuantity(12345, metric.Watt), expected: "1.234e+04 W"},
+		{name: "General", format: "%g", quantity: metric.NewQuantity(0.5, metric.Second), expected: "0.5 s"},
+		{name: "ValuePrecision", format: "%.3v", quantity: metric.NewQuantity(3.14159, metric.Meter), expected: "3.14 m"},
+		{name: "Width", format: "%10.1f|", quantity: metric.NewQuantity(2.25, metric.Area), expected: "    2.2 m²|"},
+		{name: "LeftAligned", format: "%-8v|", quantity: metric.NewQuantity(2, metric.Area), expected: "2 m²    |"},
+		{name: "Verbose", format: "%+v", quantity: metric.NewQuantity(12, metric.Meter), expected: "12 meters"},
+		{name: "VerboseSingular", format: "%+v", quantity: metric.NewQuantity(1, metric.Meter), expected: "1 meter"},
+		{name: "VerboseCompound", format: "%+.1f", quantity: metric.NewQuantity(8, metric.BitPerSecond), expected: "8.0 bits per second"},
+		{name: "VerbosePrefixed", format: "%+v", quantity: metric.NewQuantity(12, kilometer), expected: "12 kilometers"},
+		{name: "VerboseSuppliedPlural", format: "%+v", quantity: metric.NewQuantity(3, foot), expected: "3 feet"},
+		{name: "VerboseBitRate", format: "%+v", quantity: metric.NewQuantity(3, metric.MegabitPerSecond), expected: "3 megabits per second"},
+		{name: "VerboseWithoutPlural", format: "%+v", quantity: metric.NewQuantity(12, metric.Celsius), expected: "12 celsius"},
+		{name: "VerboseUninflected", format: "%+v", quantity: metric.NewQuantity(3, metric.Lux), expected: "3 lux"},
+		{name: "ASCII", format: "%#v", quantity: metric.NewQuantity(2, metric.Area), expected: "2 m^2"},
+		{name: "ASCIIPrefix", format: "%#v", quantity: metric.NewQuantity(5, metric.Microsecond), expected: "5 us"},
+		{name: "ASCIIDegree", format: "%#.1f", quantity: metric.NewQuantity(90, metric.Degree), expected: "90.0 deg"},
+		{name: "BadVerb", format: "%d", quantity: metric.NewQuantity(1, metric.Meter), expected: "%!d(1 m)"},
+		{name: "Measured", format: "%v", quantity: metric.NewMeasuredQuantity(12.3, 0.05, metric.Meter), expected: "12.30 ± 0.05 m"},
+		{name: "MeasuredFixed", format: "%#.3f", quantity: metric.NewMeasuredQuantity(12.3, 0.05, metric.Area), expected: "12.300 +/- 0.050 m^2"},
+		{name: "MeasuredVerbose", format: "%+s", quantity: metric.NewMeasuredQuantity(12.3, 0.05, metric.Meter), expected: "12.30 ± 0.05 meters"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(fmt.Sprintf(tt.format, tt.quantity), tt.expected)
+		})
+	}
+}
+
+func TestASCIISymbol(t *testing.T) {
+	tests := []struct {
+		symbol   string
+		expected string
+	}{
+		{symbol: "m", expected: "m"},
+		{symbol: "m²", expected: "m^2"},
+		{symbol: "m³", expected: "m^3"},
+		{symbol: "m·s⁻¹", expected: "m*s^-1"},
+		{symbol: "µm", expected: "um"},
+		{symbol: "°C", expected: "degC"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.symbol, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(metric.ASCIISymbol(tt.symbol), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package metric
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	IECSystemOfUnits = NewSystemOfUnits("IEC 80000-13", "IEC")
+
+	Bit = WithPlural(NewBaseUnit(
+		"bit",
+		"The bit is the basic unit of information, representing a logical state with one of two possible values",
+		"bit",
+		IECSystemOfUnits,
+	), "bits")
+	Byte = WithPlural(NewBaseUnit(
+		"byte",
+		"The byte is a unit of information equal to 8 bits",
+		"B",
+		IECSystemOfUnits,
+	), "bytes")
+
+	Kilobyte = newByteMultiple("kilobyte", "kB", "10^3")
+	Megabyte = newByteMultiple("megabyte", "MB", "10^6")
+	Gigabyte = newByteMultiple("gigabyte", "GB", "10^9")
+	Terabyte = newByteMultiple("terabyte", "TB", "10^12")
+
+	Kilobit = newBitMultiple("kilobit", "kbit", "10^3")
+	Megabit = newBitMultiple("megabit", "Mbit", "10^6")
+	Gigabit = newBitMultiple("gigabit", "Gbit", "10^9")
+
+	Kibibyte = newByteMultiple("kibibyte", "KiB", "2^10")
+	Mebibyte = newByteMultiple("mebibyte", "MiB", "2^20")
+	Gibibyte = newByteMultiple("gibibyte", "GiB", "2^30")
+	Tebibyte = newByteMultiple("tebibyte", "TiB", "2^40")
+
+	BitPerSecond = WithPlural(NewDerivedUnit(
+		"bit per second",
+		"The bit per second is the rate of transfer of one bit of information per second",
+		"bit/s",
+		IECSystemOfUnits,
+		NewDerivedUnitTerm(Bit, 1),
+		NewDerivedUnitTerm(Second, -1),
+	), "bits per second")
+	KilobitPerSecond = newBitRate("kilobit per second", "kbit/s", "10^3", Kilobit)
+	MegabitPerSecond = newBitRate("megabit per second", "Mbit/s", "10^6", Megabit)
+	GigabitPerSecond = newBitRate("gigabit per second", "Gbit/s", "10^9", Gigabit)
+	BytePerSecond    = WithPlural(NewDerivedUnit(
+		"byte per second",
+		"The byte per second is the rate of transfer of one byte of information per second",
+		"B/s",
+		IECSystemOfUnits,
+		NewDerivedUnitTerm(Byte, 1),
+		NewDerivedUnitTerm(Second, -1),
+	), "bytes per second")
+
+	// DecimalByteUnits lists byte units with decimal (SI) prefixes in ascending order.
+	DecimalByteUnits = []Unit{Byte, Kilobyte, Megabyte, Gigabyte, Terabyte}
+
+	// BinaryByteUnits lists byte units with binary (IEC) prefixes in ascending order.
+	BinaryByteUnits = []Unit{Byte, Kibibyte, Mebibyte, Gibibyte, Tebibyte}
+)
+
+func init() {
+	newLinearConversions(
+		linearUnit{Bit, 1, 1},
+		linearUnit{Byte, 8, 1},
+		linearUnit{Kilobit, 1e3, 1},
+		linearUnit{Megabit, 1e6, 1},
+		linearUnit{Gigabit, 1e9, 1},
+		linearUnit{Kilobyte, 8e3, 1},
+		linearUnit{Megabyte, 8e6, 1},
+		linearUnit{Gigabyte, 8e9, 1},
+		linearUnit{Terabyte, 8e12, 1},
+		linearUnit{Kibibyte, 8 << 10, 1},
+		linearUnit{Mebibyte, 8 << 20, 1},
+		linearUnit{Gibibyte, 8 << 30, 1},
+		linearUnit{Tebibyte, 8 << 40, 1},
+	)
+
+	newLinearConversions(
+		linearUnit{BitPerSecond, 1, 1},
+		linearUnit{KilobitPerSecond, 1e3, 1},
+		linearUnit{MegabitPerSecond, 1e6, 1},
+		linearUnit{GigabitPerSecond, 1e9, 1},
+		linearUnit{BytePerSecond, 8, 1},
+	)
+}
+
+// newByteMultiple creates a multiple of the byte without terms, as described on FindDerivedUnit.
+func newByteMultiple(name, symbol, multiplier string) Unit {
+	return WithPlural(NewBaseUnit(
+		name,
+		fmt.Sprintf("The %s is a unit of information equal to %s bytes", name, multiplier),
+		symbol,
+		IECSystemOfUnits,
+	), name+"s")
+}
+
+// newBitMultiple creates a multiple of the bit without terms, as described on FindDerivedUnit.
+func newBitMultiple(name, symbol, multiplier string) Unit {
+	return WithPlural(NewBaseUnit(
+		name,
+		fmt.Sprintf("The %s is a unit of information equal to %s bits", name, multiplier),
+		symbol,
+		IECSystemOfUnits,
+	), name+"s")
+}
+
+// newBitRate creates the rate of transfer of a multiple of the bit per second.
+func newBitRate(name, symbol, multiplier string, bits Unit) Unit {
+	return WithPlural(NewDerivedUnit(
+		name,
+		fmt.Sprintf("The %s is the rate of transfer of %s bits of information per second", name, multiplier),
+		symbol,
+		IECSystemOfUnits,
+		NewDerivedUnitTerm(bits, 1),
+		NewDerivedUnitTerm(Second, -1),
+	), pluralName(bits)+" per second")
+}
+
+// ScaleToFit converts the Quantity to the largest of the given units in which its absolute amount is at least 1.
+// The units must be listed in ascending order. If the amount is smaller than 1 in every unit, the first unit is used.
+func ScaleToFit(quantity Quantity, units ...Unit) (Quantity, error) {
+	if len(units) == 0 {
+		return nil, ErrNoConversion
+	}
+
+	best, err := UnitConverter.Convert(quantity, units[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, unit := range units[1:] {
+		converted, err := UnitConverter.Convert(quantity, unit)
+		if err != nil {
+			return nil, err
+		}
+		if math.Abs(converted.Amount()) < 1 {
+			break
+		}
+		best = converted
+	}
+
+	return best, nil
+}
+
+// FormatInformation formats an information Quantity using the largest fitting byte unit
+// with at most two decimal places, e.g., "1.5 GiB". Binary selects IEC prefixes (KiB, MiB, ...) over SI prefixes (kB, MB, ...).
+func FormatInformation(quantity Quantity, binary bool) (string, error) {
+	units := DecimalByteUnits
+	if binary {
+		units = BinaryByteUnits
+	}
+
+	scaled, err := ScaleToFit(quantity, units...)
+	if err != nil {
+		return "", err
+	}
+
+	amount := math.Round(scaled.Amount()*100) / 100
+
+	return fmt.Sprintf("%s %s", strconv.FormatFloat(amount, 'f', -1, 64), scaled.Metric().Symbol()), nil
+}
@@ -0,0 +1,122 @@
+package metric_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestInformationConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   metric.Unit
+		expected float64
+	}{
+		{name: "ByteToBit", quantity: metric.NewQuantity(2, metric.Byte), target: metric.Bit, expected: 16},
+		{name: "KilobyteToByte", quantity: metric.NewQuantity(1.5, metric.Kilobyte), target: metric.Byte, expected: 1500},
+		{name: "KibibyteToByte", quantity: metric.NewQuantity(1.5, metric.Kibibyte), target: metric.Byte, expected: 1536},
+		{name: "GibibyteToMebibyte", quantity: metric.NewQuantity(2, metric.Gibibyte), target: metric.Mebibyte, expected: 2048},
+		{name: "GigabyteToMegabyte", quantity: metric.NewQuantity(2, metric.Gigabyte), target: metric.Megabyte, expected: 2000},
+		{name: "MebibyteToMegabyte", quantity: metric.NewQuantity(1, metric.Mebibyte), target: metric.Megabyte, expected: 1.048576},
+		{name: "MegabitPerSecondToBytePerSecond", quantity: metric.NewQuantity(8, metric.MegabitPerSecond), target: metric.BytePerSecond, expected: 1_000_000},
+		{name: "BytePerSecondToKilobitPerSecond", quantity: metric.NewQuantity(1000, metric.BytePerSecond), target: metric.KilobitPerSecond, expected: 8},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, tt.target)
+			is.NoErr(err)
+			is.Equal(converted.Amount(), tt.expected)
+			is.Equal(converted.Metric(), tt.target)
+		})
+	}
+}
+
+func TestInformationUnits(t *testing.T) {
+	is := isser.New(t)
+
+	is.Equal(metric.Bit.SystemOfUnits(), metric.IECSystemOfUnits)
+	is.Equal(metric.Gibibyte.Symbol(), "GiB")
+
+	terms := metric.BitPerSecond.Terms()
+	is.Equal(len(terms), 2)
+	is.Equal(terms[0].Metric(), metric.Bit)
+	is.Equal(terms[1].Metric(), metric.Second)
+	is.Equal(terms[1].Exponent(), -1)
+
+	_, err := metric.UnitConverter.Convert(metric.NewQuantity(1, metric.Byte), metric.BytePerSecond)
+	is.Equal(err, metric.ErrNoConversion)
+}
+
+func TestFormatInformation(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		binary   bool
+		expected string
+	}{
+		{name: "Binary", quantity: metric.NewQuantity(1.5*1024*1024*1024, metric.Byte), binary: true, expected: "1.5 GiB"},
+		{name: "Decimal", quantity: metric.NewQuantity(1_500_000, metric.Byte), binary: false, expected: "1.5 MB"},
+		{name: "FromBits", quantity: metric.NewQuantity(8192, metric.Bit), binary: true, expected: "1 KiB"},
+		{name: "Rounded", quantity: metric.NewQuantity(1234567, metric.Byte), binary: false, expected: "1.23 MB"},
+		{name: "Small", quantity: metric.NewQuantity(512, metric.Byte), binary: true, expected: "512 B"},
+		{name: "Fraction", quantity: metric.NewQuantity(4, metric.Bit), binary: true, expected: "0.5 B"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			formatted, err := metric.FormatInformation(tt.quantity, tt.binary)
+			is.NoErr(err)
+			is.Equal(formatted, tt.expected)
+		})
+	}
+}
+
+func TestInformationUnitsHideNoScale(t *testing.T) {
+	is := isser.New(t)
+
+	_, ok := metric.FindDerivedUnit(metric.NewDerivedUnitTerm(metric.Bit, 1))
+	is.True(!ok)
+
+	rate, ok := metric.FindDerivedUnit(metric.NewDerivedUnitTerm(metric.Bit, 1), metric.NewDerivedUnitTerm(metric.Second, -1))
+	is.True(ok)
+	is.Equal(rate, metric.BitPerSecond)
+
+	for _, unit := range []metric.Unit{metric.Byte, metric.Kilobyte, metric.Kibibyte, metric.Kilobit} {
+		_, derived := unit.(metric.DerivedUnit)
+		is.True(!derived)
+	}
+}
+
+func TestBitRatesFromTerms(t *testing.T) {
+	tests := []struct {
+		bits     metric.Unit
+		expected metric.Unit
+	}{
+		{bits: metric.Kilobit, expected: metric.KilobitPerSecond},
+		{bits: metric.Megabit, expected: metric.MegabitPerSecond},
+		{bits: metric.Gigabit, expected: metric.GigabitPerSecond},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expected.Symbol(), func(t *testing.T) {
+			is := isser.New(t)
+
+			terms := []metric.DerivedUnitTerm{metric.NewDerivedUnitTerm(tt.bits, 1), metric.NewDerivedUnitTerm(metric.Second, -1)}
+
+			found, ok := metric.FindDerivedUnit(terms...)
+			is.True(ok)
+			is.Equal(found, tt.expected)
+			is.Equal(metric.UnitOf(terms...), tt.expected)
+		})
+	}
+}
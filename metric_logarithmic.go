@@ -0,0 +1,325 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvalidLevel = errors.New("level is undefined for non-positive values")
+)
+
+// LogarithmicUnit describes a Unit expressing a quantity as the logarithm of a ratio.
+//
+// Ratio units (Decibel, Bel, Neper) have no reference and express gains or attenuations.
+// Level units (e.g. DecibelMilliwatt) express a quantity relative to a reference Quantity.
+// Levels cannot be added, subtracted or scaled linearly; use SumLevels, AddGain and LevelDifference instead.
+type LogarithmicUnit interface {
+	Unit
+
+	// Reference returns the reference Quantity of a level unit or nil for ratio units.
+	Reference() Quantity
+
+	// Decibels returns how many decibels one unit equals, e.g. 10 for the bel.
+	Decibels() float64
+
+	// ToLinear returns the linear value of the level, in the unit of the Reference.
+	// For ratio units, the returned Quantity is a ratio in One.
+	ToLinear(level Quantity) (Quantity, error)
+
+	// FromLinear returns the level of the linear value, which must be convertible to the unit of the Reference.
+	// For ratio units, the value must be dimensionless.
+	FromLinear(value Quantity) (Quantity, error)
+}
+
+var (
+	Decibel = WithPlural(NewLogarithmicUnit(
+		"decibel",
+		"The decibel expresses the ratio of two power quantities as ten times its common logarithm",
+		"dB",
+		NonSISystemOfUnits,
+		1, false, nil,
+	), "decibels")
+	Bel = WithPlural(NewLogarithmicUnit(
+		"bel",
+		"The bel expresses the ratio of two power quantities as its common logarithm",
+		"B",
+		NonSISystemOfUnits,
+		10, false, nil,
+	), "bels")
+	Neper = WithPlural(NewLogarithmicUnit(
+		"neper",
+		"The neper expresses the ratio of two root-power quantities as its natural logarithm",
+		"Np",
+		NonSISystemOfUnits,
+		20/math.Ln10, true, nil,
+	), "nepers")
+	DecibelMilliwatt = NewLogarithmicUnit(
+		"decibel-milliwatt",
+		"The decibel-milliwatt expresses a power level in decibels relative to one milliwatt",
+		"dBm",
+		NonSISystemOfUnits,
+		1, false, NewQuantity(0.001, Watt),
+	)
+	DecibelWatt = NewLogarithmicUnit(
+		"decibel-watt",
+		"The decibel-watt expresses a power level in decibels relative to one watt",
+		"dBW",
+		NonSISystemOfUnits,
+		1, false, NewQuantity(1, Watt),
+	)
+	DecibelVolt = NewLogarithmicUnit(
+		"decibel-volt",
+		"The decibel-volt expresses a voltage level in decibels relative to one volt",
+		"dBV",
+		NonSISystemOfUnits,
+		1, true, NewQuantity(1, Volt),
+	)
+	PH = NewLogarithmicUnit(
+		"pH",
+		"The pH is the negative common logarithm of the hydrogen ion activity",
+		"pH",
+		NonSISystemOfUnits,
+		-10, false, NewQuantity(1, One),
+	)
+)
+
+func init() {
+	ratios := []LogarithmicUnit{Decibel, Bel, Neper}
+	for _, source := range ratios {
+		for _, target := range ratios {
+			if source == target {
+				continue
+			}
+
+			source, target := source, target
+			NewStandardConversion(source, target, func(quantity Quantity) (Quantity, error) {
+				return NewQuantity(quantity.Amount()*source.Decibels()/target.Decibels(), target), nil
+			})
+		}
+	}
+
+	newLevelConversions(DecibelMilliwatt, DecibelWatt)
+	newLevelConversions(DecibelMilliwatt, Watt)
+	newLevelConversions(DecibelWatt, Watt)
+	newLevelConversions(DecibelVolt, Volt)
+	newLevelConversions(PH, One)
+}
+
+type logarithmicUnitImpl struct {
+	name          string
+	plural        string
+	definition    string
+	symbol        string
+	systemOfUnits SystemOfUnits
+
+	decibels  float64
+	rootPower bool
+	reference Quantity
+}
+
+// NewLogarithmicUnit creates a new LogarithmicUnit.
+// The decibels is the number of decibels one unit equals.
+// The rootPower is true for units of root-power (field) quantities like voltage, for which 20·log10 is used instead of 10·log10.
+// The reference is the Quantity a level is relative to, or nil for ratio units.
+func NewLogarithmicUnit(name, definition, symbol string, systemOfUnits SystemOfUnits, decibels float64, rootPower bool, reference Quantity) LogarithmicUnit {
+	unit := &logarithmicUnitImpl{
+		name:          name,
+		definition:    definition,
+		symbol:        symbol,
+		systemOfUnits: systemOfUnits,
+		decibels:      decibels,
+		rootPower:     rootPower,
+		reference:     reference,
+	}
+
+	if systemOfUnits != nil {
+		systemOfUnits.appendUnit(unit)
+	}
+
+	return unit
+}
+
+func (l *logarithmicUnitImpl) Name() string {
+	return l.name
+}
+
+func (l *logarithmicUnitImpl) PluralName() string {
+	if l.plural == "" {
+		return l.name
+	}
+	return l.plural
+}
+
+func (l *logarithmicUnitImpl) setPlural(plural string) {
+	l.plural = plural
+}
+
+func (l *logarithmicUnitImpl) Definition() string {
+	return l.definition
+}
+
+func (l *logarithmicUnitImpl) Symbol() string {
+	return l.symbol
+}
+
+func (l *logarithmicUnitImpl) String() string {
+	return l.Symbol()
+}
+
+func (l *logarithmicUnitImpl) SystemOfUnits() SystemOfUnits {
+	return l.systemOfUnits
+}
+
+func (l *logarithmicUnitImpl) Reference() Quantity {
+	return l.reference
+}
+
+func (l *logarithmicUnitImpl) Decibels() float64 {
+	return l.decibels
+}
+
+// scale returns the factor between decibels and the common logarithm of the linear ratio.
+func (l *logarithmicUnitImpl) scale() float64 {
+	if l.rootPower {
+		return 20
+	}
+	return 10
+}
+
+func (l *logarithmicUnitImpl) ToLinear(level Quantity) (Quantity, error) {
+	if level.Metric() != l {
+		return nil, ErrIncompatibleMetric{level.Metric(), l}
+	}
+
+	ratio := math.Pow(10, level.Amount()*l.decibels/l.scale())
+	if l.reference == nil {
+		return NewQuantity(ratio, One), nil
+	}
+
+	return NewQuantity(ratio*l.reference.Amount(), l.reference.Metric()), nil
+}
+
+func (l *logarithmicUnitImpl) FromLinear(value Quantity) (Quantity, error) {
+	var ratio float64
+
+	if l.reference == nil {
+		if !IsDimensionless(value.Metric()) {
+			return nil, ErrIncompatibleMetric{value.Metric(), One}
+		}
+
+		converted, err := UnitConverter.Convert(value, One)
+		if err != nil {
+			return nil, err
+		}
+		ratio = converted.Amount()
+	} else {
+		unit, ok := l.reference.Metric().(Unit)
+		if !ok {
+			return nil, ErrMetricIsNotUnit
+		}
+
+		converted, err := UnitConverter.Convert(value, unit)
+		if err != nil {
+			return nil, err
+		}
+		ratio = converted.Amount() / l.reference.Amount()
+	}
+
+	if ratio <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLevel, value)
+	}
+
+	return NewQuantity(l.scale()*math.Log10(ratio)/l.decibels, l), nil
+}
+
+// newLevelConversions registers conversions in both directions between a level unit
+// and either another level unit or a linear unit, going through the linear value.
+func newLevelConversions(level LogarithmicUnit, other Unit) {
+	toOther := func(quantity Quantity) (Quantity, error) {
+		linear, err := level.ToLinear(quantity)
+		if err != nil {
+			return nil, err
+		}
+		if target, ok := other.(LogarithmicUnit); ok {
+			return target.FromLinear(linear)
+		}
+		return UnitConverter.Convert(linear, other)
+	}
+
+	fromOther := func(quantity Quantity) (Quantity, error) {
+		if source, ok := other.(LogarithmicUnit); ok {
+			linear, err := source.ToLinear(quantity)
+			if err != nil {
+				return nil, err
+			}
+			return level.FromLinear(linear)
+		}
+		return level.FromLinear(quantity)
+	}
+
+	NewStandardConversion(level, other, toOther)
+	NewStandardConversion(other, level, fromOther)
+}
+
+// IsLevel returns true if the Metric is a LogarithmicUnit relative to a reference, e.g. DecibelMilliwatt.
+func IsLevel(metric Metric) bool {
+	unit, ok := metric.(LogarithmicUnit)
+	return ok && unit.Reference() != nil
+}
+
+// SumLevels returns the level of the incoherent (power) sum of the given levels, e.g. 3 dB + 3 dB = 6.02 dB.
+// Precondition: all levels must be in the same LogarithmicUnit.
+func SumLevels(levels ...Quantity) (Quantity, error) {
+	if len(levels) == 0 {
+		return nil, ErrInvalidLevel
+	}
+
+	unit, ok := levels[0].Metric().(LogarithmicUnit)
+	if !ok {
+		return nil, ErrIncompatibleMetric{levels[0].Metric(), Decibel}
+	}
+
+	var power float64
+	for _, level := range levels {
+		if level.Metric() != unit {
+			return nil, ErrIncompatibleMetric{unit, level.Metric()}
+		}
+		power += math.Pow(10, level.Amount()*unit.Decibels()/10)
+	}
+
+	return NewQuantity(10*math.Log10(power)/unit.Decibels(), unit), nil
+}
+
+// AddGain applies a gain (or attenuation, if negative) expressed in a ratio unit to a level or another gain,
+// e.g. 10 dBm + 3 dB = 13 dBm.
+func AddGain(level, gain Quantity) (Quantity, error) {
+	unit, ok := level.Metric().(LogarithmicUnit)
+	if !ok {
+		return nil, ErrIncompatibleMetric{level.Metric(), Decibel}
+	}
+
+	gainUnit, ok := gain.Metric().(LogarithmicUnit)
+	if !ok || gainUnit.Reference() != nil {
+		return nil, ErrIncompatibleMetric{gain.Metric(), Decibel}
+	}
+
+	decibels := gain.Amount() * gainUnit.Decibels()
+
+	return NewQuantity(level.Amount()+decibels/unit.Decibels(), unit), nil
+}
+
+// LevelDifference returns the ratio between two levels in Decibels, e.g. 20 dBm - 10 dBm = 10 dB.
+// Precondition: both levels must be in the same LogarithmicUnit.
+func LevelDifference(level1, level2 Quantity) (Quantity, error) {
+	unit, ok := level1.Metric().(LogarithmicUnit)
+	if !ok {
+		return nil, ErrIncompatibleMetric{level1.Metric(), Decibel}
+	}
+	if level2.Metric() != unit {
+		return nil, ErrIncompatibleMetric{unit, level2.Metric()}
+	}
+
+	return NewQuantity((level1.Amount()-level2.Amount())*unit.Decibels(), Decibel), nil
+}
@@ -0,0 +1,115 @@
+package metric_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func roundTo(f float64, digits int) float64 {
+	ratio := math.Pow(10, float64(digits))
+	return math.Round(f*ratio) / ratio
+}
+
+func TestLogarithmicConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   metric.Unit
+		expected float64
+	}{
+		{name: "DecibelMilliwattToWatt", quantity: metric.NewQuantity(30, metric.DecibelMilliwatt), target: metric.Watt, expected: 1},
+		{name: "WattToDecibelMilliwatt", quantity: metric.NewQuantity(0.1, metric.Watt), target: metric.DecibelMilliwatt, expected: 20},
+		{name: "DecibelMilliwattToDecibelWatt", quantity: metric.NewQuantity(0, metric.DecibelMilliwatt), target: metric.DecibelWatt, expected: -30},
+		{name: "DecibelWattToDecibelMilliwatt", quantity: metric.NewQuantity(10, metric.DecibelWatt), target: metric.DecibelMilliwatt, expected: 40},
+		{name: "DecibelVoltToVolt", quantity: metric.NewQuantity(20, metric.DecibelVolt), target: metric.Volt, expected: 10},
+		{name: "VoltToDecibelVolt", quantity: metric.NewQuantity(0.1, metric.Volt), target: metric.DecibelVolt, expected: -20},
+		{name: "BelToDecibel", quantity: metric.NewQuantity(2, metric.Bel), target: metric.Decibel, expected: 20},
+		{name: "NeperToDecibel", quantity: metric.NewQuantity(1, metric.Neper), target: metric.Decibel, expected: 8.685889638},
+		{name: "PHToOne", quantity: metric.NewQuantity(7, metric.PH), target: metric.One, expected: 1e-7},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, tt.target)
+			is.NoErr(err)
+			is.Equal(roundTo(converted.Amount(), 9), roundTo(tt.expected, 9))
+			is.Equal(converted.Metric(), tt.target)
+		})
+	}
+}
+
+func TestLogarithmicUnit(t *testing.T) {
+	is := isser.New(t)
+
+	linear, err := metric.Neper.ToLinear(metric.NewQuantity(1, metric.Neper))
+	is.NoErr(err)
+	is.Equal(roundTo(linear.Amount(), 12), roundTo(math.E, 12))
+	is.Equal(linear.Metric(), metric.One)
+
+	level, err := metric.PH.FromLinear(metric.NewQuantity(1e-3, metric.One))
+	is.NoErr(err)
+	is.Equal(roundTo(level.Amount(), 12), 3.0)
+
+	_, err = metric.DecibelMilliwatt.FromLinear(metric.NewQuantity(0, metric.Watt))
+	is.True(errors.Is(err, metric.ErrInvalidLevel))
+
+	is.True(metric.IsLevel(metric.DecibelMilliwatt))
+	is.True(!metric.IsLevel(metric.Decibel))
+	is.True(!metric.IsLevel(metric.Watt))
+}
+
+func TestLevelArithmetic(t *testing.T) {
+	is := isser.New(t)
+
+	sum, err := metric.SumLevels(
+		metric.NewQuantity(3, metric.DecibelMilliwatt),
+		metric.NewQuantity(3, metric.DecibelMilliwatt),
+	)
+	is.NoErr(err)
+	is.Equal(roundTo(sum.Amount(), 2), 6.01)
+	is.Equal(sum.Metric(), metric.DecibelMilliwatt)
+
+	_, err = metric.SumLevels(
+		metric.NewQuantity(3, metric.DecibelMilliwatt),
+		metric.NewQuantity(3, metric.DecibelWatt),
+	)
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.DecibelMilliwatt, M2: metric.DecibelWatt})
+
+	amplified, err := metric.AddGain(metric.NewQuantity(10, metric.DecibelMilliwatt), metric.NewQuantity(0.3, metric.Bel))
+	is.NoErr(err)
+	is.Equal(amplified.Amount(), 13.0)
+
+	_, err = metric.AddGain(metric.NewQuantity(10, metric.DecibelMilliwatt), metric.NewQuantity(3, metric.DecibelWatt))
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.DecibelWatt, M2: metric.Decibel})
+
+	difference, err := metric.LevelDifference(metric.NewQuantity(20, metric.DecibelMilliwatt), metric.NewQuantity(10, metric.DecibelMilliwatt))
+	is.NoErr(err)
+	is.Equal(difference.Amount(), 10.0)
+	is.Equal(difference.Metric(), metric.Decibel)
+
+	level := metric.NewQuantity(10, metric.DecibelMilliwatt)
+
+	_, err = level.Add(level)
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+	_, err = level.Subtract(level)
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+	_, err = level.Multiply(2)
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+	_, err = level.Divide(2)
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+	_, err = level.MultiplyBy(metric.NewQuantity(1, metric.Second))
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+	_, err = metric.NewQuantity(1, metric.Second).DivideBy(metric.NewQuantity(3, metric.Decibel))
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+
+	gains, err := metric.NewQuantity(3, metric.Decibel).Add(metric.NewQuantity(3, metric.Decibel))
+	is.NoErr(err)
+	is.Equal(gains.Amount(), 6.0)
+}
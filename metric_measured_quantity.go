@@ -0,0 +1,241 @@
+package metric
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// MeasuredQuantity is a Quantity carrying a standard uncertainty.
+//
+// Arithmetic propagates the uncertainty with the first-order rules of the
+// Guide to the Expression of Uncertainty in Measurement (GUM), assuming the operands are uncorrelated.
+// Use AddCorrelated, SubtractCorrelated, MultiplyByCorrelated and DivideByCorrelated for correlated operands.
+// Conversions performed by the UnitConverter propagate the uncertainty as well.
+type MeasuredQuantity interface {
+	Quantity
+
+	// Uncertainty returns the standard uncertainty in the Metric of the Quantity.
+	Uncertainty() float64
+
+	// RelativeUncertainty returns the uncertainty divided by the absolute amount.
+	RelativeUncertainty() float64
+
+	// Concise returns the concise notation, e.g. "12.30(5) m".
+	Concise() string
+}
+
+type measuredQuantityImpl struct {
+	*quantityImpl
+	uncertainty float64
+}
+
+// NewMeasuredQuantity creates a MeasuredQuantity of amount ± uncertainty.
+func NewMeasuredQuantity(amount, uncertainty float64, metric Metric) MeasuredQuantity {
+	return &measuredQuantityImpl{
+		quantityImpl: &quantityImpl{
+			amount: amount,
+			metric: metric,
+		},
+		uncertainty: math.Abs(uncertainty),
+	}
+}
+
+// UncertaintyOf returns the standard uncertainty of the Quantity, or 0 if it is not a MeasuredQuantity.
+func UncertaintyOf(quantity Quantity) float64 {
+	if measured, ok := quantity.(MeasuredQuantity); ok {
+		return measured.Uncertainty()
+	}
+	return 0
+}
+
+func (q *measuredQuantityImpl) Uncertainty() float64 {
+	return q.uncertainty
+}
+
+func (q *measuredQuantityImpl) RelativeUncertainty() float64 {
+	return q.uncertainty / math.Abs(q.amount)
+}
+
+// String returns the Quantity in the "12.30 ± 0.05 m" notation.
+func (q *measuredQuantityImpl) String() string {
+	value, uncertainty, _ := q.formatParts()
+	if uncertainty == "" {
+		return fmt.Sprintf("%s %s", value, q.metric)
+	}
+
+	return fmt.Sprintf("%s ± %s %s", value, uncertainty, q.metric)
+}
+
+func (q *measuredQuantityImpl) Concise() string {
+	value, _, digits := q.formatParts()
+	if digits == "" {
+		return fmt.Sprintf("%s %s", value, q.metric)
+	}
+
+	return fmt.Sprintf("%s(%s) %s", value, digits, q.metric)
+}
+
+// formatParts rounds the uncertainty to two significant digits when its leading digit is 1 or 2, to one otherwise,
+// and rounds the value to the same decimal place. It returns the value, the uncertainty
+// and the significant digits of the uncertainty used by the concise notation.
+func (q *measuredQuantityImpl) formatParts() (value, uncertainty, digits string) {
+	if q.uncertainty == 0 || math.IsInf(q.uncertainty, 0) || math.IsNaN(q.uncertainty) {
+		return strconv.FormatFloat(q.amount, 'f', -1, 64), "", ""
+	}
+
+	exponent := int(math.Floor(math.Log10(q.uncertainty)))
+	leading := q.uncertainty / math.Pow(10, float64(exponent))
+
+	significant := 1
+	if leading < 3 {
+		significant = 2
+	}
+
+	place := exponent - significant + 1
+	// Rounding may carry the uncertainty into the next power of ten, e.g. 0.096 to 0.10.
+	rounded := math.Round(q.uncertainty/math.Pow(10, float64(place))) * math.Pow(10, float64(place))
+	if newExponent := int(math.Floor(math.Log10(rounded))); newExponent > exponent && significant == 1 {
+		place++
+	}
+
+	decimals := 0
+	if place < 0 {
+		decimals = -place
+	}
+
+	uncertainty = strconv.FormatFloat(rounded, 'f', decimals, 64)
+	if place > 0 {
+		// Digits left of the decimal point are written out in full, e.g. 1230(60).
+		scale := math.Pow(10, float64(place))
+		return strconv.FormatFloat(math.Round(q.amount/scale)*scale, 'f', 0, 64), uncertainty, uncertainty
+	}
+
+	value = strconv.FormatFloat(q.amount, 'f', decimals, 64)
+	digits = strconv.FormatFloat(math.Round(q.uncertainty/math.Pow(10, float64(place))), 'f', 0, 64)
+
+	return value, uncertainty, digits
+}
+
+func (q *measuredQuantityImpl) Add(q2 Quantity) (Quantity, error) {
+	return AddCorrelated(q, q2, 0)
+}
+
+func (q *measuredQuantityImpl) Subtract(q2 Quantity) (Quantity, error) {
+	return SubtractCorrelated(q, q2, 0)
+}
+
+func (q *measuredQuantityImpl) Multiply(multiplier float64) (Quantity, error) {
+	product, err := q.quantityImpl.Multiply(multiplier)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMeasuredQuantity(product.Amount(), q.uncertainty*math.Abs(multiplier), product.Metric()), nil
+}
+
+func (q *measuredQuantityImpl) MultiplyBy(q2 Quantity) (Quantity, error) {
+	return MultiplyByCorrelated(q, q2, 0)
+}
+
+func (q *measuredQuantityImpl) Round(policy RoundingPolicy) (Quantity, error) {
+	return NewMeasuredQuantity(policy.Round(q.amount), q.uncertainty, q.metric), nil
+}
+
+func (q *measuredQuantityImpl) Divide(divisor float64) (Quantity, error) {
+	quotient, err := q.quantityImpl.Divide(divisor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMeasuredQuantity(quotient.Amount(), q.uncertainty/math.Abs(divisor), quotient.Metric()), nil
+}
+
+func (q *measuredQuantityImpl) DivideBy(divisor Quantity) (Quantity, error) {
+	return DivideByCorrelated(q, divisor, 0)
+}
+
+// AddCorrelated adds two quantities whose uncertainties have the given correlation coefficient in [-1, 1].
+func AddCorrelated(q1, q2 Quantity, correlation float64) (MeasuredQuantity, error) {
+	sum, err := NewQuantity(q1.Amount(), q1.Metric()).Add(q2)
+	if err != nil {
+		return nil, err
+	}
+
+	u := propagate(1, UncertaintyOf(q1), 1, UncertaintyOf(q2), correlation)
+
+	return NewMeasuredQuantity(sum.Amount(), u, sum.Metric()), nil
+}
+
+// SubtractCorrelated subtracts two quantities whose uncertainties have the given correlation coefficient in [-1, 1].
+func SubtractCorrelated(q1, q2 Quantity, correlation float64) (MeasuredQuantity, error) {
+	difference, err := NewQuantity(q1.Amount(), q1.Metric()).Subtract(q2)
+	if err != nil {
+		return nil, err
+	}
+
+	u := propagate(1, UncertaintyOf(q1), -1, UncertaintyOf(q2), correlation)
+
+	return NewMeasuredQuantity(difference.Amount(), u, difference.Metric()), nil
+}
+
+// MultiplyByCorrelated multiplies two quantities whose uncertainties have the given correlation coefficient in [-1, 1].
+func MultiplyByCorrelated(q1, q2 Quantity, correlation float64) (MeasuredQuantity, error) {
+	product, err := NewQuantity(q1.Amount(), q1.Metric()).MultiplyBy(q2)
+	if err != nil {
+		return nil, err
+	}
+
+	u := propagate(q2.Amount(), UncertaintyOf(q1), q1.Amount(), UncertaintyOf(q2), correlation)
+
+	return NewMeasuredQuantity(product.Amount(), u, product.Metric()), nil
+}
+
+// DivideByCorrelated divides two quantities whose uncertainties have the given correlation coefficient in [-1, 1].
+func DivideByCorrelated(q1, q2 Quantity, correlation float64) (MeasuredQuantity, error) {
+	if q2.Amount() == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	quotient, err := NewQuantity(q1.Amount(), q1.Metric()).DivideBy(q2)
+	if err != nil {
+		return nil, err
+	}
+
+	b := q2.Amount()
+	u := propagate(1/b, UncertaintyOf(q1), -q1.Amount()/(b*b), UncertaintyOf(q2), correlation)
+
+	return NewMeasuredQuantity(quotient.Amount(), u, quotient.Metric()), nil
+}
+
+// propagate combines two uncertainties given the sensitivity coefficients (partial derivatives) of a function
+// of two variables: u² = (c1·u1)² + (c2·u2)² + 2·r·(c1·u1)·(c2·u2).
+func propagate(c1, u1, c2, u2, correlation float64) float64 {
+	a, b := c1*u1, c2*u2
+	return math.Sqrt(math.Max(0, a*a+b*b+2*correlation*a*b))
+}
+
+// convertMeasured converts the MeasuredQuantity, propagating its uncertainty through the conversion
+// with the central difference approximation of the derivative.
+func convertMeasured(quantity MeasuredQuantity, convert func(Quantity) (Quantity, error)) (Quantity, error) {
+	value, err := convert(NewQuantity(quantity.Amount(), quantity.Metric()))
+	if err != nil {
+		return nil, err
+	}
+
+	u := quantity.Uncertainty()
+	if u == 0 {
+		return NewMeasuredQuantity(value.Amount(), 0, value.Metric()), nil
+	}
+
+	upper, err := convert(NewQuantity(quantity.Amount()+u, quantity.Metric()))
+	if err != nil {
+		return nil, err
+	}
+	lower, err := convert(NewQuantity(quantity.Amount()-u, quantity.Metric()))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMeasuredQuantity(value.Amount(), math.Abs(upper.Amount()-lower.Amount())/2, value.Metric()), nil
+}
@@ -0,0 +1,212 @@
+package metric_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestMeasuredQuantity(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(is *isser.I)
+	}{
+		{
+			name: "Add",
+			check: func(is *isser.I) {
+				sum, err := metric.NewMeasuredQuantity(3, 0.3, metric.Meter).Add(metric.NewMeasuredQuantity(4, 0.4, metric.Meter))
+				is.NoErr(err)
+				is.Equal(sum.Amount(), 7.0)
+				is.Equal(roundTo(metric.UncertaintyOf(sum), 12), 0.5)
+			},
+		},
+		{
+			name: "AddPlainQuantity",
+			check: func(is *isser.I) {
+				sum, err := metric.NewMeasuredQuantity(3, 0.3, metric.Meter).Add(metric.NewQuantity(4, metric.Meter))
+				is.NoErr(err)
+				is.Equal(sum.Amount(), 7.0)
+				is.Equal(metric.UncertaintyOf(sum), 0.3)
+			},
+		},
+		{
+			name: "AddIncompatible",
+			check: func(is *isser.I) {
+				for _, unit := range []metric.Unit{metric.Second, metric.Kilogram} {
+					_, err := metric.NewMeasuredQuantity(3, 0.3, metric.Meter).Add(metric.NewQuantity(4, unit))
+					is.True(errors.As(err, &metric.ErrIncompatibleMetric{}))
+				}
+			},
+		},
+		{
+			name: "Subtract",
+			check: func(is *isser.I) {
+				difference, err := metric.NewMeasuredQuantity(10, 0.3, metric.Meter).Subtract(metric.NewMeasuredQuantity(4, 0.4, metric.Meter))
+				is.NoErr(err)
+				is.Equal(difference.Amount(), 6.0)
+				is.Equal(roundTo(metric.UncertaintyOf(difference), 12), 0.5)
+			},
+		},
+		{
+			name: "Multiply",
+			check: func(is *isser.I) {
+				product, err := metric.NewMeasuredQuantity(2, 0.1, metric.Meter).Multiply(-3)
+				is.NoErr(err)
+				is.Equal(product.Amount(), -6.0)
+				is.Equal(roundTo(metric.UncertaintyOf(product), 12), 0.3)
+			},
+		},
+		{
+			name: "Divide",
+			check: func(is *isser.I) {
+				quotient, err := metric.NewMeasuredQuantity(6, 0.3, metric.Meter).Divide(3)
+				is.NoErr(err)
+				is.Equal(quotient.Amount(), 2.0)
+				is.Equal(roundTo(metric.UncertaintyOf(quotient), 12), 0.1)
+
+				_, err = metric.NewMeasuredQuantity(6, 0.3, metric.Meter).Divide(0)
+				is.True(errors.Is(err, metric.ErrDivisionByZero))
+			},
+		},
+		{
+			name: "MultiplyBy",
+			check: func(is *isser.I) {
+				// Relative uncertainties of 3% and 4% combine into 5%.
+				area, err := metric.NewMeasuredQuantity(10, 0.3, metric.Meter).MultiplyBy(metric.NewMeasuredQuantity(5, 0.2, metric.Meter))
+				is.NoErr(err)
+				is.Equal(area.Amount(), 50.0)
+				is.Equal(roundTo(metric.UncertaintyOf(area), 12), 2.5)
+			},
+		},
+		{
+			name: "DivideBy",
+			check: func(is *isser.I) {
+				speed, err := metric.NewMeasuredQuantity(100, 3, metric.Meter).DivideBy(metric.NewMeasuredQuantity(10, 0.4, metric.Second))
+				is.NoErr(err)
+				is.Equal(speed.Amount(), 10.0)
+				is.Equal(roundTo(metric.UncertaintyOf(speed), 12), 0.5)
+
+				_, err = metric.NewMeasuredQuantity(100, 3, metric.Meter).DivideBy(metric.NewQuantity(0, metric.Second))
+				is.True(errors.Is(err, metric.ErrDivisionByZero))
+			},
+		},
+		{
+			name: "AddCorrelated",
+			check: func(is *isser.I) {
+				a := metric.NewMeasuredQuantity(3, 0.3, metric.Meter)
+				b := metric.NewMeasuredQuantity(4, 0.4, metric.Meter)
+
+				sum, err := metric.AddCorrelated(a, b, 1)
+				is.NoErr(err)
+				is.Equal(roundTo(sum.Uncertainty(), 12), 0.7)
+
+				difference, err := metric.SubtractCorrelated(a, b, 1)
+				is.NoErr(err)
+				is.Equal(roundTo(difference.Uncertainty(), 12), 0.1)
+			},
+		},
+		{
+			name: "DivideByFullyCorrelated",
+			check: func(is *isser.I) {
+				// A quantity divided by itself has no uncertainty.
+				a := metric.NewMeasuredQuantity(5, 0.2, metric.Meter)
+
+				ratio, err := metric.DivideByCorrelated(a, a, 1)
+				is.NoErr(err)
+				is.Equal(ratio.Amount(), 1.0)
+				is.Equal(roundTo(ratio.Uncertainty(), 12), 0.0)
+
+				product, err := metric.MultiplyByCorrelated(a, a, 1)
+				is.NoErr(err)
+				is.Equal(roundTo(product.Uncertainty(), 12), 2.0)
+			},
+		},
+		{
+			name: "ConvertLinear",
+			check: func(is *isser.I) {
+				converted, err := metric.UnitConverter.Convert(metric.NewMeasuredQuantity(1.5, 0.1, metric.Degree), metric.Arcminute)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 12), 90.0)
+				is.Equal(roundTo(metric.UncertaintyOf(converted), 12), 6.0)
+				is.Equal(converted.Metric(), metric.Arcminute)
+			},
+		},
+		{
+			name: "ConvertWithOffset",
+			check: func(is *isser.I) {
+				converted, err := metric.UnitConverter.Convert(metric.NewMeasuredQuantity(20, 0.5, metric.Celsius), metric.Kelvin)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 12), 293.15)
+				is.Equal(roundTo(metric.UncertaintyOf(converted), 12), 0.5)
+			},
+		},
+		{
+			name: "ConvertIdentity",
+			check: func(is *isser.I) {
+				converted, err := metric.UnitConverter.Convert(metric.NewMeasuredQuantity(20, 0.5, metric.Meter), metric.Meter)
+				is.NoErr(err)
+				is.Equal(metric.UncertaintyOf(converted), 0.5)
+			},
+		},
+		{
+			name: "RelativeUncertainty",
+			check: func(is *isser.I) {
+				is.Equal(metric.NewMeasuredQuantity(-50, 2.5, metric.Meter).RelativeUncertainty(), 0.05)
+				is.True(math.IsInf(metric.NewMeasuredQuantity(0, 2.5, metric.Meter).RelativeUncertainty(), 1))
+			},
+		},
+		{
+			name: "Round",
+			check: func(is *isser.I) {
+				rounded, err := metric.NewMeasuredQuantity(12.345, 0.05, metric.Meter).Round(metric.Round(1, 5))
+				is.NoErr(err)
+				is.Equal(rounded.Amount(), 12.3)
+				is.Equal(metric.UncertaintyOf(rounded), 0.05)
+			},
+		},
+		{
+			name: "UncertaintyOfPlainQuantity",
+			check: func(is *isser.I) {
+				is.Equal(metric.UncertaintyOf(metric.NewQuantity(1, metric.Meter)), 0.0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(isser.New(t))
+		})
+	}
+}
+
+func TestMeasuredQuantityFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		amount      float64
+		uncertainty float64
+		plusMinus   string
+		concise     string
+	}{
+		{name: "OneDigit", amount: 12.3, uncertainty: 0.05, plusMinus: "12.30 ± 0.05 m", concise: "12.30(5) m"},
+		{name: "TwoDigits", amount: 1.23456, uncertainty: 0.0123, plusMinus: "1.235 ± 0.012 m", concise: "1.235(12) m"},
+		{name: "CarryOver", amount: 4.567, uncertainty: 0.096, plusMinus: "4.6 ± 0.1 m", concise: "4.6(1) m"},
+		{name: "Integer", amount: 1234, uncertainty: 5, plusMinus: "1234 ± 5 m", concise: "1234(5) m"},
+		{name: "Large", amount: 1234, uncertainty: 56, plusMinus: "1230 ± 60 m", concise: "1230(60) m"},
+		{name: "Exact", amount: 12.3, uncertainty: 0, plusMinus: "12.3 m", concise: "12.3 m"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			quantity := metric.NewMeasuredQuantity(tt.amount, tt.uncertainty, metric.Meter)
+			is.Equal(quantity.String(), tt.plusMinus)
+			is.Equal(quantity.Concise(), tt.concise)
+		})
+	}
+}
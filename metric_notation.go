@@ -0,0 +1,46 @@
+package metric
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// FormatScientific formats the Quantity in scientific notation with the given number of significant figures,
+// e.g. 0.00042 m with 3 figures becomes "4.20e-4 m".
+func FormatScientific(quantity Quantity, figures int) string {
+	mantissa, exponent := splitExponent(quantity.Amount(), figures, 1)
+	return fmt.Sprintf("%se%d %s", mantissa, exponent, quantity.Metric().Symbol())
+}
+
+// FormatEngineering formats the Quantity in engineering notation, with an exponent that is a multiple of three,
+// and the given number of significant figures, e.g. 0.00042 m with 3 figures becomes "420e-6 m".
+func FormatEngineering(quantity Quantity, figures int) string {
+	mantissa, exponent := splitExponent(quantity.Amount(), figures, 3)
+	return fmt.Sprintf("%se%d %s", mantissa, exponent, quantity.Metric().Symbol())
+}
+
+// splitExponent rounds f to the given number of significant figures and splits it into a formatted mantissa
+// and an exponent that is a multiple of step. Trailing zeros are kept as they are significant.
+func splitExponent(f float64, figures, step int) (string, int) {
+	if figures < 1 {
+		figures = 1
+	}
+
+	rounded := RoundSignificant(figures).Round(f)
+	if rounded == 0 || math.IsInf(rounded, 0) || math.IsNaN(rounded) {
+		return strconv.FormatFloat(rounded, 'f', figures-1, 64), 0
+	}
+
+	// The exponent is taken after rounding, so that e.g. 9.996 with 3 figures becomes 1.00e1 rather than 10.0e0.
+	exponent := int(math.Floor(math.Log10(math.Abs(rounded))))
+	shifted := int(math.Floor(float64(exponent)/float64(step))) * step
+
+	decimals := max(figures-1-(exponent-shifted), 0)
+	mantissa := rounded / math.Pow(10, float64(shifted))
+	if shifted < 0 {
+		mantissa = rounded * math.Pow(10, float64(-shifted))
+	}
+
+	return strconv.FormatFloat(mantissa, 'f', decimals, 64), shifted
+}
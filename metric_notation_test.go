@@ -0,0 +1,35 @@
+package metric_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestFormatNotation(t *testing.T) {
+	tests := []struct {
+		name        string
+		quantity    metric.Quantity
+		figures     int
+		scientific  string
+		engineering string
+	}{
+		{name: "Small", quantity: metric.NewQuantity(0.00042, metric.Meter), figures: 3, scientific: "4.20e-4 m", engineering: "420e-6 m"},
+		{name: "Large", quantity: metric.NewQuantity(12345, metric.Watt), figures: 2, scientific: "1.2e4 W", engineering: "12e3 W"},
+		{name: "Negative", quantity: metric.NewQuantity(-0.0123, metric.Second), figures: 2, scientific: "-1.2e-2 s", engineering: "-12e-3 s"},
+		{name: "RoundingCarry", quantity: metric.NewQuantity(9.996, metric.Meter), figures: 3, scientific: "1.00e1 m", engineering: "10.0e0 m"},
+		{name: "Unit", quantity: metric.NewQuantity(1, metric.Meter), figures: 1, scientific: "1e0 m", engineering: "1e0 m"},
+		{name: "Zero", quantity: metric.NewQuantity(0, metric.Meter), figures: 3, scientific: "0.00e0 m", engineering: "0.00e0 m"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			is.Equal(metric.FormatScientific(tt.quantity, tt.figures), tt.scientific)
+			is.Equal(metric.FormatEngineering(tt.quantity, tt.figures), tt.engineering)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrUnknownUnit     = errors.New("unknown unit")
+	ErrAmbiguousUnit   = errors.New("ambiguous unit")
+	ErrInvalidQuantity = errors.New("invalid quantity")
+)
+
+// LookupUnit returns the unit with the given symbol or name, e.g. "km" or "kilometer".
+// Units of the systems of units of SystemsOfUnits and the prefixed SI units are searched by symbol, then by name,
+// then by the aliases of RegisterAlias. A symbol shared by several units, such as "B" of the bel
+// and the byte, is rejected with ErrAmbiguousUnit rather than resolved to one of them; their names tell them apart.
+func LookupUnit(symbol string) (Unit, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("%w: empty symbol", ErrUnknownUnit)
+	}
+
+	for _, match := range []func(symbol, name string) bool{
+		func(s, _ string) bool { return s == symbol },
+		func(_, name string) bool { return name == symbol },
+	} {
+		var found []Unit
+		add := func(unit Unit) {
+			if !containsUnit(found, unit) {
+				found = append(found, unit)
+			}
+		}
+
+		for _, system := range SystemsOfUnits() {
+			for _, unit := range system.Units() {
+				if match(unit.Symbol(), unit.Name()) {
+					add(unit)
+				}
+			}
+		}
+
+		// Prefixed units are matched by the symbol and name they would have, and created only if they match.
+		for _, base := range prefixableUnits {
+			for _, prefix := range SIPrefixes {
+				if unit, ok := createdPrefixed(base, prefix.Exponent); ok {
+					if match(unit.Symbol(), unit.Name()) {
+						add(unit)
+					}
+					continue
+				}
+				if match(prefix.Symbol+base.Symbol(), prefix.Name+base.Name()) {
+					unit, err := Prefixed(prefix, base)
+					if err != nil {
+						return nil, err
+					}
+					add(unit)
+				}
+			}
+		}
+
+		if len(found) > 0 {
+			return onlyUnit(symbol, found)
+		}
+	}
+
+	if found := aliased(symbol); len(found) > 0 {
+		return onlyUnit(symbol, found)
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownUnit, symbol)
+}
+
+// onlyUnit returns the unit found for the symbol, or ErrAmbiguousUnit if there are several.
+func onlyUnit(symbol string, found []Unit) (Unit, error) {
+	if len(found) == 1 {
+		return found[0], nil
+	}
+
+	names := make([]string, len(found))
+	for i, unit := range found {
+		names[i] = unit.Name()
+	}
+	return nil, fmt.Errorf("%w: %q is the symbol of %s", ErrAmbiguousUnit, symbol, strings.Join(names, ", "))
+}
+
+// ParseQuantity parses an amount followed by the symbol or name of a unit, e.g. "5 km", "1.5e3m" or "20 °C".
+// The unit is looked up with LookupUnit; an amount without a unit is a Quantity of One.
+func ParseQuantity(s string) (Quantity, error) {
+	input := strings.TrimSpace(s)
+
+	end := numberLength(input)
+	if end == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidQuantity, s)
+	}
+
+	amount, err := strconv.ParseFloat(input[:end], 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidQuantity, s)
+	}
+
+	symbol := strings.TrimSpace(input[end:])
+	if symbol == "" {
+		return NewQuantity(amount, One), nil
+	}
+
+	unit, err := LookupUnit(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQuantity(amount, unit), nil
+}
+
+// numberLength returns the length of the decimal number at the start of s, with an optional sign and exponent.
+// An "e" starts an exponent only if digits follow, so that "5em" is not mistaken for a number.
+func numberLength(s string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+
+	digits := 0
+	for i < len(s) && (unicode.IsDigit(rune(s[i])) || s[i] == '.') {
+		if s[i] != '.' {
+			digits++
+		}
+		i++
+	}
+	if digits == 0 {
+		return 0
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && unicode.IsDigit(rune(s[j])) {
+			for j < len(s) && unicode.IsDigit(rune(s[j])) {
+				j++
+			}
+			i = j
+		}
+	}
+
+	return i
+}
@@ -0,0 +1,167 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestLookupUnit(t *testing.T) {
+	kilometer, err := metric.Prefixed(metric.Kilo, metric.Meter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		symbol   string
+		expected metric.Unit
+	}{
+		{name: "Symbol", symbol: "m", expected: metric.Meter},
+		{name: "Name", symbol: "meter", expected: metric.Meter},
+		{name: "DerivedUnit", symbol: "m/s", expected: metric.Speed},
+		{name: "NonSI", symbol: "min", expected: metric.Minute},
+		{name: "IEC", symbol: "GiB", expected: metric.Gibibyte},
+		{name: "Prefixed", symbol: "km", expected: kilometer},
+		{name: "PrefixedName", symbol: "kilometer", expected: kilometer},
+		{name: "ExistingPrefixed", symbol: "ms", expected: metric.Millisecond},
+		{name: "ByteName", symbol: "byte", expected: metric.Byte},
+		{name: "BelName", symbol: "bel", expected: metric.Bel},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			unit, err := metric.LookupUnit(tt.symbol)
+			is.NoErr(err)
+			is.Equal(unit, tt.expected)
+		})
+	}
+
+	t.Run("Unknown", func(t *testing.T) {
+		is := isser.New(t)
+
+		_, err := metric.LookupUnit("furlong")
+		is.True(errors.Is(err, metric.ErrUnknownUnit))
+	})
+
+	t.Run("Ambiguous", func(t *testing.T) {
+		is := isser.New(t)
+
+		system := metric.NewSystemOfUnits("Ambiguous", "Tests")
+		metric.NewBaseUnit("ambiguous one", "A unit sharing its symbol", "amb", system)
+		metric.NewBaseUnit("ambiguous two", "A unit sharing its symbol", "amb", system)
+		metric.RegisterSystemOfUnits(system)
+
+		_, err := metric.LookupUnit("amb")
+		is.True(errors.Is(err, metric.ErrAmbiguousUnit))
+
+		// The bel and the byte share "B".
+		_, err = metric.LookupUnit("B")
+		is.True(errors.Is(err, metric.ErrAmbiguousUnit))
+
+		unit, err := metric.LookupUnit("ambiguous two")
+		is.NoErr(err)
+		is.Equal(unit.Name(), "ambiguous two")
+	})
+
+	t.Run("Alias", func(t *testing.T) {
+		is := isser.New(t)
+
+		fathom := metric.NewBaseUnit("fathom", "A unit looked up by its aliases", "ftm", nil)
+		metric.RegisterAlias(fathom, "fathoms", "fath")
+		metric.RegisterAlias(fathom, "fathoms")
+
+		unit, err := metric.LookupUnit("fathoms")
+		is.NoErr(err)
+		is.Equal(unit, fathom)
+
+		// Symbols and names come before aliases.
+		metric.RegisterAlias(fathom, "m")
+		unit, err = metric.LookupUnit("m")
+		is.NoErr(err)
+		is.Equal(unit, metric.Meter)
+
+		metric.RegisterAlias(metric.Meter, "fath")
+		_, err = metric.LookupUnit("fath")
+		is.True(errors.Is(err, metric.ErrAmbiguousUnit))
+	})
+
+	t.Run("RegisteredSystem", func(t *testing.T) {
+		is := isser.New(t)
+
+		system := metric.NewSystemOfUnits("Test", "Tests")
+		league := metric.NewBaseUnit("league", "A unit of a registered system", "lea", system)
+
+		_, err := metric.LookupUnit("lea")
+		is.True(errors.Is(err, metric.ErrUnknownUnit))
+
+		metric.RegisterSystemOfUnits(system)
+		metric.RegisterSystemOfUnits(system)
+
+		unit, err := metric.LookupUnit("lea")
+		is.NoErr(err)
+		is.Equal(unit, league)
+
+		count := 0
+		for _, registered := range metric.SystemsOfUnits() {
+			if registered == system {
+				count++
+			}
+		}
+		is.Equal(count, 1)
+	})
+}
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		amount   float64
+		expected metric.Unit
+	}{
+		{name: "Spaced", input: "5 m", amount: 5, expected: metric.Meter},
+		{name: "Attached", input: "5m", amount: 5, expected: metric.Meter},
+		{name: "Negative", input: "-12.5 °C", amount: -12.5, expected: metric.Celsius},
+		{name: "Exponent", input: "1.5e3 s", amount: 1500, expected: metric.Second},
+		{name: "NotAnExponent", input: "2 mol", amount: 2, expected: metric.Mole},
+		{name: "Dimensionless", input: " 0.5 ", amount: 0.5, expected: metric.One},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			quantity, err := metric.ParseQuantity(tt.input)
+			is.NoErr(err)
+			is.Equal(quantity.Amount(), tt.amount)
+			is.Equal(quantity.Metric(), tt.expected)
+		})
+	}
+
+	errorTests := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{name: "Empty", input: "", expected: metric.ErrInvalidQuantity},
+		{name: "NoAmount", input: "m", expected: metric.ErrInvalidQuantity},
+		{name: "BadAmount", input: "1.2.3 m", expected: metric.ErrInvalidQuantity},
+		{name: "UnknownUnit", input: "5 furlong", expected: metric.ErrUnknownUnit},
+	}
+
+	for _, tt := range errorTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			_, err := metric.ParseQuantity(tt.input)
+			is.True(errors.Is(err, tt.expected))
+		})
+	}
+}
@@ -87,6 +87,9 @@ func (q *quantityImpl) Add(q2 Quantity) (Quantity, error) {
 	if q.metric != q2.Metric() {
 		return nil, ErrIncompatibleMetric{q.metric, q2.Metric()}
 	}
+	if IsLevel(q.metric) {
+		return nil, fmt.Errorf("%w: use SumLevels or AddGain to add to %s", ErrLogarithmicArithmetic, q)
+	}
 
 	return NewQuantity(q.amount+q2.Amount(), q.metric), nil
 }
@@ -95,29 +98,26 @@ func (q *quantityImpl) Subtract(q2 Quantity) (Quantity, error) {
 	if q.metric != q2.Metric() {
 		return nil, ErrIncompatibleMetric{q.metric, q2.Metric()}
 	}
+	if IsLevel(q.metric) {
+		return nil, fmt.Errorf("%w: use LevelDifference to subtract from %s", ErrLogarithmicArithmetic, q)
+	}
 
 	return NewQuantity(q.amount-q2.Amount(), q.metric), nil
 }
 
 func (q *quantityImpl) Multiply(multiplier float64) (Quantity, error) {
+	if IsLevel(q.metric) {
+		return nil, fmt.Errorf("%w: cannot multiply %s", ErrLogarithmicArithmetic, q)
+	}
 	return NewQuantity(q.amount*multiplier, q.metric), nil
 }
 
 func (q *quantityImpl) MultiplyBy(q2 Quantity) (Quantity, error) {
-	name := fmt.Sprintf("%s*%s", q.metric, q2.Metric())
-	definition := fmt.Sprintf("Describes the product of %s and %s", q.metric, q2.Metric())
-	symbol := fmt.Sprintf("%s*%s", q.metric.Symbol(), q2.Metric().Symbol())
-
-	du := NewDerivedUnit(
-		name,
-		definition,
-		symbol,
-		nil,
-		NewDerivedUnitTerm(q.metric, 1),
-		NewDerivedUnitTerm(q2.Metric(), 1),
-	)
+	if isLogarithmic(q.metric) || isLogarithmic(q2.Metric()) {
+		return nil, fmt.Errorf("%w: cannot multiply %s by %s", ErrLogarithmicArithmetic, q, q2)
+	}
 
-	return NewQuantity(q.amount*q2.Amount(), du), nil
+	return NewQuantity(q.amount*q2.Amount(), MultiplyUnits(q.metric, q2.Metric())), nil
 }
 
 func (q *quantityImpl) Round(policy RoundingPolicy) (Quantity, error) {
@@ -125,6 +125,9 @@ func (q *quantityImpl) Round(policy RoundingPolicy) (Quantity, error) {
 }
 
 func (q *quantityImpl) Divide(divisor float64) (Quantity, error) {
+	if IsLevel(q.metric) {
+		return nil, fmt.Errorf("%w: cannot divide %s", ErrLogarithmicArithmetic, q)
+	}
 	if divisor == 0 {
 		return nil, ErrDivisionByZero
 	}
@@ -132,20 +135,35 @@ func (q *quantityImpl) Divide(divisor float64) (Quantity, error) {
 }
 
 func (q *quantityImpl) DivideBy(divisor Quantity) (Quantity, error) {
-	name := fmt.Sprintf("%s/%s", q.metric, divisor.Metric())
-	definition := fmt.Sprintf("Describes the ratio between %s and %s", q.metric, divisor.Metric())
-	symbol := fmt.Sprintf("%s/%s", q.metric.Symbol(), divisor.Metric().Symbol())
-
-	du := NewDerivedUnit(
-		name,
-		definition,
-		symbol,
+	if isLogarithmic(q.metric) || isLogarithmic(divisor.Metric()) {
+		return nil, fmt.Errorf("%w: cannot divide %s by %s", ErrLogarithmicArithmetic, q, divisor)
+	}
+
+	return NewQuantity(q.amount/divisor.Amount(), DivideUnits(q.metric, divisor.Metric())), nil
+}
+
+// MultiplyUnits returns the DerivedUnit T*P of the product of quantities in the metrics, as given by Quantity.MultiplyBy.
+func MultiplyUnits(t, p Metric) DerivedUnit {
+	return NewDerivedUnit(
+		fmt.Sprintf("%s*%s", t, p),
+		fmt.Sprintf("Describes the product of %s and %s", t, p),
+		fmt.Sprintf("%s*%s", t.Symbol(), p.Symbol()),
 		nil,
-		NewDerivedUnitTerm(q.metric, 1),
-		NewDerivedUnitTerm(divisor.Metric(), -1),
+		NewDerivedUnitTerm(t, 1),
+		NewDerivedUnitTerm(p, 1),
 	)
+}
 
-	return NewQuantity(q.amount/divisor.Amount(), du), nil
+// DivideUnits returns the DerivedUnit T/P of the ratio between quantities in the metrics, as given by Quantity.DivideBy.
+func DivideUnits(t, p Metric) DerivedUnit {
+	return NewDerivedUnit(
+		fmt.Sprintf("%s/%s", t, p),
+		fmt.Sprintf("Describes the ratio between %s and %s", t, p),
+		fmt.Sprintf("%s/%s", t.Symbol(), p.Symbol()),
+		nil,
+		NewDerivedUnitTerm(t, 1),
+		NewDerivedUnitTerm(p, -1),
+	)
 }
 
 func (q *quantityImpl) Equals(q2 Quantity) (bool, error) {
@@ -174,3 +192,8 @@ func (q *quantityImpl) LessThan(q2 Quantity) (bool, error) {
 	result := big.NewFloat(q.amount).Cmp(big.NewFloat(q2.Amount()))
 	return result < 0, nil
 }
+
+func isLogarithmic(metric Metric) bool {
+	_, ok := metric.(LogarithmicUnit)
+	return ok
+}
@@ -171,3 +171,22 @@ func TestQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiplyAndDivideUnits(t *testing.T) {
+	is := isser.New(t)
+
+	product := metric.MultiplyUnits(metric.Kilogram, metric.Meter)
+	is.Equal(product.Symbol(), "kg*m")
+	is.Equal(len(product.Terms()), 2)
+	is.Equal(product.Terms()[1].Metric(), metric.Meter)
+	is.Equal(product.Terms()[1].Exponent(), 1)
+
+	ratio := metric.DivideUnits(metric.Meter, metric.Second)
+	is.Equal(ratio.Symbol(), "m/s")
+	is.Equal(ratio.Terms()[1].Metric(), metric.Second)
+	is.Equal(ratio.Terms()[1].Exponent(), -1)
+
+	q, err := metric.NewQuantity(6, metric.Meter).DivideBy(metric.NewQuantity(2, metric.Second))
+	is.NoErr(err)
+	is.Equal(q.Metric().Symbol(), ratio.Symbol())
+}
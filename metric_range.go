@@ -0,0 +1,410 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var (
+	ErrInvalidRange   = errors.New("invalid range")
+	ErrEmptyRange     = errors.New("empty range")
+	ErrDisjointRanges = errors.New("disjoint ranges")
+)
+
+// Bounds tells which bounds of a Range belong to it.
+type Bounds int
+
+const (
+	Closed    Bounds = iota // [lower, upper]
+	Open                    // (lower, upper)
+	LeftOpen                // (lower, upper]
+	RightOpen               // [lower, upper)
+)
+
+func newBounds(lowerInclusive, upperInclusive bool) Bounds {
+	switch {
+	case lowerInclusive && upperInclusive:
+		return Closed
+	case lowerInclusive:
+		return RightOpen
+	case upperInclusive:
+		return LeftOpen
+	default:
+		return Open
+	}
+}
+
+// LowerInclusive returns true if the lower bound belongs to the Range.
+func (b Bounds) LowerInclusive() bool {
+	return b == Closed || b == RightOpen
+}
+
+// UpperInclusive returns true if the upper bound belongs to the Range.
+func (b Bounds) UpperInclusive() bool {
+	return b == Closed || b == LeftOpen
+}
+
+// Range is an interval of quantities of the same Metric, e.g. an operating range of 10–20 °C or a tolerance of 5 mm ± 0.1 mm.
+type Range interface {
+	Lower() Quantity
+	Upper() Quantity
+	Bounds() Bounds
+	Metric() Metric
+
+	// String returns "10–20 °C" for closed ranges and the interval notation otherwise, e.g. "[10, 20) °C".
+	String() string
+
+	// Contains returns true if the Quantity is within the Range
+	// Precondition: the Quantity must be in the Metric of the Range
+	Contains(Quantity) (bool, error)
+
+	// Clamp returns the Quantity limited to the bounds of the Range, which are returned even if they are excluded
+	// Precondition: the Quantity must be in the Metric of the Range
+	Clamp(Quantity) (Quantity, error)
+
+	// Intersect returns the quantities within both ranges, or ErrEmptyRange if there are none
+	// Precondition: both ranges must be in the same Metric
+	Intersect(Range) (Range, error)
+
+	// Union returns the quantities within either range, or ErrDisjointRanges if they do not form a single Range
+	// Precondition: both ranges must be in the same Metric
+	Union(Range) (Range, error)
+
+	// Add returns the range of the sums of the quantities of both ranges, e.g. [1, 2] m + [10, 20] m = [11, 22] m
+	// Precondition: both ranges must be in the same Metric
+	Add(Range) (Range, error)
+
+	// MultiplyBy returns the range of the products of the quantities of both ranges, e.g. [1, 2] m * [-1, 3] s = [-2, 6] m*s
+	// The Metric of the returned Range is a DerivedUnit T*P as given by Quantity.MultiplyBy
+	MultiplyBy(Range) (Range, error)
+}
+
+type rangeImpl struct {
+	lower, upper float64
+	bounds       Bounds
+	metric       Metric
+}
+
+// NewRange creates a Range of the quantities between lower and upper, which must be in the same Metric.
+// It returns ErrInvalidRange if lower is greater than upper or if they are equal and either is excluded.
+func NewRange(lower, upper Quantity, bounds Bounds) (Range, error) {
+	if lower.Metric() != upper.Metric() {
+		return nil, ErrIncompatibleMetric{lower.Metric(), upper.Metric()}
+	}
+	return newRange(lower.Amount(), upper.Amount(), bounds, lower.Metric())
+}
+
+// NewTolerance creates the closed Range nominal ± tolerance, e.g. 5 mm ± 0.1 mm for [4.9, 5.1] mm.
+func NewTolerance(nominal, tolerance Quantity) (Range, error) {
+	if nominal.Metric() != tolerance.Metric() {
+		return nil, ErrIncompatibleMetric{nominal.Metric(), tolerance.Metric()}
+	}
+
+	deviation := math.Abs(tolerance.Amount())
+	return newRange(nominal.Amount()-deviation, nominal.Amount()+deviation, Closed, nominal.Metric())
+}
+
+func newRange(lower, upper float64, bounds Bounds, metric Metric) (Range, error) {
+	if bounds < Closed || bounds > RightOpen {
+		return nil, fmt.Errorf("%w: unknown bounds %d", ErrInvalidRange, bounds)
+	}
+	if math.IsNaN(lower) || math.IsNaN(upper) || lower > upper || lower == upper && bounds != Closed {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRange, &rangeImpl{lower, upper, bounds, metric})
+	}
+
+	return &rangeImpl{lower: lower, upper: upper, bounds: bounds, metric: metric}, nil
+}
+
+func (r *rangeImpl) Lower() Quantity {
+	return NewQuantity(r.lower, r.metric)
+}
+
+func (r *rangeImpl) Upper() Quantity {
+	return NewQuantity(r.upper, r.metric)
+}
+
+func (r *rangeImpl) Bounds() Bounds {
+	return r.bounds
+}
+
+func (r *rangeImpl) Metric() Metric {
+	return r.metric
+}
+
+func (r *rangeImpl) String() string {
+	if r.bounds == Closed {
+		return fmt.Sprintf("%v–%v %s", r.lower, r.upper, r.metric)
+	}
+
+	opening, closing := "(", ")"
+	if r.bounds.LowerInclusive() {
+		opening = "["
+	}
+	if r.bounds.UpperInclusive() {
+		closing = "]"
+	}
+	return fmt.Sprintf("%s%v, %v%s %s", opening, r.lower, r.upper, closing, r.metric)
+}
+
+func (r *rangeImpl) Contains(q Quantity) (bool, error) {
+	if r.metric != q.Metric() {
+		return false, ErrIncompatibleMetric{r.metric, q.Metric()}
+	}
+
+	amount := q.Amount()
+	aboveLower := amount > r.lower || amount == r.lower && r.bounds.LowerInclusive()
+	belowUpper := amount < r.upper || amount == r.upper && r.bounds.UpperInclusive()
+	return aboveLower && belowUpper, nil
+}
+
+func (r *rangeImpl) Clamp(q Quantity) (Quantity, error) {
+	if r.metric != q.Metric() {
+		return nil, ErrIncompatibleMetric{r.metric, q.Metric()}
+	}
+
+	switch {
+	case q.Amount() < r.lower:
+		return r.Lower(), nil
+	case q.Amount() > r.upper:
+		return r.Upper(), nil
+	}
+	return q, nil
+}
+
+func (r *rangeImpl) Intersect(r2 Range) (Range, error) {
+	if r.metric != r2.Metric() {
+		return nil, ErrIncompatibleMetric{r.metric, r2.Metric()}
+	}
+
+	lower, lowerInclusive := r.lower, r.bounds.LowerInclusive()
+	switch other := r2.Lower().Amount(); {
+	case other > lower:
+		lower, lowerInclusive = other, r2.Bounds().LowerInclusive()
+	case other == lower:
+		lowerInclusive = lowerInclusive && r2.Bounds().LowerInclusive()
+	}
+
+	upper, upperInclusive := r.upper, r.bounds.UpperInclusive()
+	switch other := r2.Upper().Amount(); {
+	case other < upper:
+		upper, upperInclusive = other, r2.Bounds().UpperInclusive()
+	case other == upper:
+		upperInclusive = upperInclusive && r2.Bounds().UpperInclusive()
+	}
+
+	if lower > upper || lower == upper && !(lowerInclusive && upperInclusive) {
+		return nil, fmt.Errorf("%w: %s and %s do not overlap", ErrEmptyRange, r, r2)
+	}
+
+	return &rangeImpl{lower, upper, newBounds(lowerInclusive, upperInclusive), r.metric}, nil
+}
+
+func (r *rangeImpl) Union(r2 Range) (Range, error) {
+	if r.metric != r2.Metric() {
+		return nil, ErrIncompatibleMetric{r.metric, r2.Metric()}
+	}
+
+	// The ranges form a single Range if they overlap or touch at a bound belonging to either of them.
+	first, second := Range(r), r2
+	if r2.Lower().Amount() < r.lower {
+		first, second = r2, r
+	}
+	gap := second.Lower().Amount() - first.Upper().Amount()
+	if gap > 0 || gap == 0 && !first.Bounds().UpperInclusive() && !second.Bounds().LowerInclusive() {
+		return nil, fmt.Errorf("%w: %s and %s", ErrDisjointRanges, r, r2)
+	}
+
+	lower, lowerInclusive := r.lower, r.bounds.LowerInclusive()
+	switch other := r2.Lower().Amount(); {
+	case other < lower:
+		lower, lowerInclusive = other, r2.Bounds().LowerInclusive()
+	case other == lower:
+		lowerInclusive = lowerInclusive || r2.Bounds().LowerInclusive()
+	}
+
+	upper, upperInclusive := r.upper, r.bounds.UpperInclusive()
+	switch other := r2.Upper().Amount(); {
+	case other > upper:
+		upper, upperInclusive = other, r2.Bounds().UpperInclusive()
+	case other == upper:
+		upperInclusive = upperInclusive || r2.Bounds().UpperInclusive()
+	}
+
+	return &rangeImpl{lower, upper, newBounds(lowerInclusive, upperInclusive), r.metric}, nil
+}
+
+func (r *rangeImpl) Add(r2 Range) (Range, error) {
+	if r.metric != r2.Metric() {
+		return nil, ErrIncompatibleMetric{r.metric, r2.Metric()}
+	}
+	if IsLevel(r.metric) {
+		return nil, fmt.Errorf("%w: cannot add to %s", ErrLogarithmicArithmetic, r)
+	}
+
+	return &rangeImpl{
+		lower:  r.lower + r2.Lower().Amount(),
+		upper:  r.upper + r2.Upper().Amount(),
+		bounds: newBounds(r.bounds.LowerInclusive() && r2.Bounds().LowerInclusive(), r.bounds.UpperInclusive() && r2.Bounds().UpperInclusive()),
+		metric: r.metric,
+	}, nil
+}
+
+func (r *rangeImpl) MultiplyBy(r2 Range) (Range, error) {
+	if isLogarithmic(r.metric) || isLogarithmic(r2.Metric()) {
+		return nil, fmt.Errorf("%w: cannot multiply %s by %s", ErrLogarithmicArithmetic, r, r2)
+	}
+
+	// The extremes of the products are products of the bounds. A product is attained if both of its bounds are,
+	// or if either of them is an attained zero.
+	type bound struct {
+		amount    float64
+		inclusive bool
+	}
+	left := []bound{{r.lower, r.bounds.LowerInclusive()}, {r.upper, r.bounds.UpperInclusive()}}
+	right := []bound{{r2.Lower().Amount(), r2.Bounds().LowerInclusive()}, {r2.Upper().Amount(), r2.Bounds().UpperInclusive()}}
+
+	lower := bound{math.Inf(1), false}
+	upper := bound{math.Inf(-1), false}
+	for _, a := range left {
+		for _, b := range right {
+			p := bound{a.amount * b.amount, a.inclusive && b.inclusive || a.inclusive && a.amount == 0 || b.inclusive && b.amount == 0}
+			switch {
+			case p.amount < lower.amount:
+				lower = p
+			case p.amount == lower.amount:
+				lower.inclusive = lower.inclusive || p.inclusive
+			}
+			switch {
+			case p.amount > upper.amount:
+				upper = p
+			case p.amount == upper.amount:
+				upper.inclusive = upper.inclusive || p.inclusive
+			}
+		}
+	}
+
+	return &rangeImpl{lower.amount, upper.amount, newBounds(lower.inclusive, upper.inclusive), MultiplyUnits(r.metric, r2.Metric())}, nil
+}
+
+// ConvertRange converts both bounds of the range to the target unit.
+func (c *defaultUnitConverter) ConvertRange(r Range, target Unit) (Range, error) {
+	lower, err := c.Convert(r.Lower(), target)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := c.Convert(r.Upper(), target)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRange(lower.Amount(), upper.Amount(), r.Bounds(), target)
+}
+
+// ParseRange parses a range of quantities in one of the forms
+//
+//	10–20 °C, 10-20 °C or 10 °C – 20 °C   closed range
+//	[10, 20) °C or (1 km, 1500 m]         interval notation, with "[" and "]" for included bounds
+//	5 mm ± 0.1 mm, 5 ± 0.1 mm or 5 mm +/- 0.1 mm   tolerance
+//
+// A bound without a unit takes the unit of the other one; a bound in another unit is converted with the UnitConverter.
+func ParseRange(s string) (Range, error) {
+	input := strings.TrimSpace(s)
+	invalid := fmt.Errorf("%w: %q", ErrInvalidRange, s)
+
+	if input == "" {
+		return nil, invalid
+	}
+
+	if input[0] == '[' || input[0] == '(' {
+		closing := strings.IndexAny(input, "])")
+		if closing < 0 {
+			return nil, invalid
+		}
+		lower, upper, ok := strings.Cut(input[1:closing], ",")
+		if !ok {
+			return nil, invalid
+		}
+		unit := strings.TrimSpace(input[closing+1:])
+
+		l, u, err := parseBounds(appendUnit(lower, unit), appendUnit(upper, unit))
+		if err != nil {
+			return nil, err
+		}
+		return NewRange(l, u, newBounds(input[0] == '[', input[closing] == ']'))
+	}
+
+	for _, separator := range []string{"±", "+/-"} {
+		if nominal, tolerance, ok := strings.Cut(input, separator); ok {
+			n, t, err := parseBounds(nominal, tolerance)
+			if err != nil {
+				return nil, err
+			}
+			return NewTolerance(n, t)
+		}
+	}
+
+	separator := strings.IndexAny(input, "–—")
+	size := len("–")
+	if separator < 0 {
+		// A hyphen separates the bounds only after the lower one, which may be negative, e.g. "-10-20 °C".
+		start := numberLength(input)
+		if start == 0 {
+			return nil, invalid
+		}
+		if separator = strings.IndexByte(input[start:], '-'); separator >= 0 {
+			separator += start
+		}
+		size = 1
+	}
+	if separator < 0 {
+		return nil, invalid
+	}
+
+	l, u, err := parseBounds(input[:separator], input[separator+size:])
+	if err != nil {
+		return nil, err
+	}
+	return NewRange(l, u, Closed)
+}
+
+func appendUnit(bound, unit string) string {
+	if unit == "" {
+		return bound
+	}
+	return bound + " " + unit
+}
+
+// parseBounds parses two quantities, giving a bound without a unit the unit of the other one
+// and converting the second bound to the unit of the first one if they differ.
+func parseBounds(first, second string) (Quantity, Quantity, error) {
+	q1, err := ParseQuantity(first)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+	q2, err := ParseQuantity(second)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+	}
+
+	switch {
+	case q1.Metric() == q2.Metric():
+		return q1, q2, nil
+	case q1.Metric() == One:
+		return NewQuantity(q1.Amount(), q2.Metric()), q2, nil
+	case q2.Metric() == One:
+		return q1, NewQuantity(q2.Amount(), q1.Metric()), nil
+	}
+
+	unit, ok := q1.Metric().(Unit)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidRange, ErrMetricIsNotUnit)
+	}
+	converted, err := UnitConverter.Convert(q2, unit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: converting %s to %s: %w", ErrInvalidRange, q2, unit, err)
+	}
+
+	return q1, converted, nil
+}
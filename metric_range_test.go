@@ -0,0 +1,182 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func mustRange(t *testing.T, s string) metric.Range {
+	t.Helper()
+
+	r, err := metric.ParseRange(s)
+	if err != nil {
+		t.Fatalf("ParseRange(%q): %v", s, err)
+	}
+	return r
+}
+
+func TestNewRange(t *testing.T) {
+	is := isser.New(t)
+
+	r, err := metric.NewRange(metric.NewQuantity(10, metric.Celsius), metric.NewQuantity(20, metric.Celsius), metric.RightOpen)
+	is.NoErr(err)
+	is.Equal(r.Lower().String(), "10 °C")
+	is.Equal(r.Upper().String(), "20 °C")
+	is.Equal(r.Bounds(), metric.RightOpen)
+	is.True(r.Bounds().LowerInclusive())
+	is.True(!r.Bounds().UpperInclusive())
+	is.Equal(r.Metric(), metric.Celsius)
+
+	_, err = metric.NewRange(metric.NewQuantity(20, metric.Celsius), metric.NewQuantity(10, metric.Celsius), metric.Closed)
+	is.True(errors.Is(err, metric.ErrInvalidRange))
+
+	_, err = metric.NewRange(metric.NewQuantity(10, metric.Celsius), metric.NewQuantity(10, metric.Celsius), metric.Open)
+	is.True(errors.Is(err, metric.ErrInvalidRange))
+
+	_, err = metric.NewRange(metric.NewQuantity(10, metric.Celsius), metric.NewQuantity(20, metric.Kelvin), metric.Closed)
+	var incompatible metric.ErrIncompatibleMetric
+	is.True(errors.As(err, &incompatible))
+
+	tolerance, err := metric.NewTolerance(metric.NewQuantity(5, metric.Meter), metric.NewQuantity(0.5, metric.Meter))
+	is.NoErr(err)
+	is.Equal(tolerance.String(), "4.5–5.5 m")
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "10–20 °C", expected: "10–20 °C"},
+		{input: "10-20 °C", expected: "10–20 °C"},
+		{input: "-10-20 °C", expected: "-10–20 °C"},
+		{input: "-20--10 °C", expected: "-20–-10 °C"},
+		{input: "10 °C – 20 °C", expected: "10–20 °C"},
+		{input: "5 mm ± 0.5 mm", expected: "4.5–5.5 mm"},
+		{input: "5 ± 0.5 mm", expected: "4.5–5.5 mm"},
+		{input: "5 mm +/- 0.5", expected: "4.5–5.5 mm"},
+		{input: "[10, 20) °C", expected: "[10, 20) °C"},
+		{input: "(10, 20] s", expected: "(10, 20] s"},
+		{input: "(1 h, 90 min)", expected: "(1, 1.5) h"},
+		{input: "[0 s, 2 s]", expected: "0–2 s"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.input, func(t *testing.T) {
+			is := isser.New(t)
+
+			r, err := metric.ParseRange(tt.input)
+			is.NoErr(err)
+			is.Equal(r.String(), tt.expected)
+
+			reparsed, err := metric.ParseRange(r.String())
+			is.NoErr(err)
+			is.Equal(reparsed.String(), tt.expected)
+		})
+	}
+
+	for _, input := range []string{"", "10 °C", "[10, 20 °C", "[10 20] °C", "20–10 °C", "10 m – 20 s", "10–20 furlong"} {
+		_, err := metric.ParseRange(input)
+		if err == nil {
+			t.Errorf("ParseRange(%q) should fail", input)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	t.Run("Contains", func(t *testing.T) {
+		is := isser.New(t)
+
+		r := mustRange(t, "[10, 20) °C")
+		for amount, expected := range map[float64]bool{9.9: false, 10: true, 15: true, 20: false, 25: false} {
+			contains, err := r.Contains(metric.NewQuantity(amount, metric.Celsius))
+			is.NoErr(err)
+			is.Equal(contains, expected)
+		}
+
+		_, err := r.Contains(metric.NewQuantity(15, metric.Kelvin))
+		is.True(err != nil)
+	})
+
+	t.Run("Clamp", func(t *testing.T) {
+		is := isser.New(t)
+
+		r := mustRange(t, "10–20 °C")
+		for amount, expected := range map[float64]string{5: "10 °C", 15: "15 °C", 25: "20 °C"} {
+			clamped, err := r.Clamp(metric.NewQuantity(amount, metric.Celsius))
+			is.NoErr(err)
+			is.Equal(clamped.String(), expected)
+		}
+	})
+
+	t.Run("Intersect", func(t *testing.T) {
+		is := isser.New(t)
+
+		intersection, err := mustRange(t, "[10, 20) °C").Intersect(mustRange(t, "15–25 °C"))
+		is.NoErr(err)
+		is.Equal(intersection.String(), "[15, 20) °C")
+
+		intersection, err = mustRange(t, "10–20 °C").Intersect(mustRange(t, "20–30 °C"))
+		is.NoErr(err)
+		is.Equal(intersection.String(), "20–20 °C")
+
+		_, err = mustRange(t, "[10, 20) °C").Intersect(mustRange(t, "20–30 °C"))
+		is.True(errors.Is(err, metric.ErrEmptyRange))
+	})
+
+	t.Run("Union", func(t *testing.T) {
+		is := isser.New(t)
+
+		union, err := mustRange(t, "15–25 °C").Union(mustRange(t, "[10, 20) °C"))
+		is.NoErr(err)
+		is.Equal(union.String(), "10–25 °C")
+
+		union, err = mustRange(t, "[10, 20) °C").Union(mustRange(t, "20–30 °C"))
+		is.NoErr(err)
+		is.Equal(union.String(), "10–30 °C")
+
+		_, err = mustRange(t, "[10, 20) °C").Union(mustRange(t, "(20, 30] °C"))
+		is.True(errors.Is(err, metric.ErrDisjointRanges))
+
+		_, err = mustRange(t, "30–40 °C").Union(mustRange(t, "10–20 °C"))
+		is.True(errors.Is(err, metric.ErrDisjointRanges))
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		is := isser.New(t)
+
+		sum, err := mustRange(t, "1–2 m").Add(mustRange(t, "[10, 20) m"))
+		is.NoErr(err)
+		is.Equal(sum.String(), "[11, 22) m")
+
+		_, err = mustRange(t, "1–2 m").Add(mustRange(t, "1–2 s"))
+		is.True(err != nil)
+	})
+
+	t.Run("MultiplyBy", func(t *testing.T) {
+		is := isser.New(t)
+
+		product, err := mustRange(t, "1–2 m").MultiplyBy(mustRange(t, "-1–3 s"))
+		is.NoErr(err)
+		is.Equal(product.String(), "-2–6 m*s")
+
+		product, err = mustRange(t, "[0, 2) m").MultiplyBy(mustRange(t, "(1, 3) s"))
+		is.NoErr(err)
+		is.Equal(product.String(), "[0, 6) m*s")
+	})
+
+	t.Run("ConvertRange", func(t *testing.T) {
+		is := isser.New(t)
+
+		converted, err := metric.UnitConverter.ConvertRange(mustRange(t, "[1, 2) h"), metric.Minute)
+		is.NoErr(err)
+		is.Equal(converted.String(), "[60, 120) min")
+
+		_, err = metric.UnitConverter.ConvertRange(mustRange(t, "1–2 h"), metric.Meter)
+		is.True(errors.Is(err, metric.ErrNoConversion))
+	})
+}
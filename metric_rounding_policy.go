@@ -71,3 +71,23 @@ func (m *metricRoundingPolicyImpl) Name() string {
 func (m *metricRoundingPolicyImpl) Round(float float64) float64 {
 	return m.roundFn(float)
 }
+
+// RoundSignificant rounds a number to the specified number of significant figures, moving halves away from zero,
+// e.g. 0.0004267 with 2 significant figures becomes 0.00043.
+func RoundSignificant(figures int) RoundingPolicy {
+	return newMetricRoundingPolicy("ROUND_SIGNIFICANT", func(f float64) float64 {
+		if f == 0 || math.IsInf(f, 0) || math.IsNaN(f) {
+			return f
+		}
+
+		shift := figures - 1 - int(math.Floor(math.Log10(math.Abs(f))))
+		// Dividing by an exact power of ten avoids the representation error of negative powers, e.g. 1e-3.
+		if shift >= 0 {
+			scale := math.Pow(10, float64(shift))
+			return math.Round(f*scale) / scale
+		}
+
+		scale := math.Pow(10, float64(-shift))
+		return math.Round(f/scale) * scale
+	})
+}
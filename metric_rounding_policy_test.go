@@ -93,6 +93,29 @@ func TestRoundingPolicy(t *testing.T) {
 				is.Equal(policy.Round(-4.45), -4.4)
 			},
 		},
+		{
+			name:   "round_significant_small",
+			policy: metric.RoundSignificant(2),
+			check: func(is *isser.I, policy metric.RoundingPolicy) {
+				is.Equal(policy.Name(), "ROUND_SIGNIFICANT")
+				is.Equal(policy.Round(0.0004267), 0.00043)
+			},
+		},
+		{
+			name:   "round_significant_large",
+			policy: metric.RoundSignificant(3),
+			check: func(is *isser.I, policy metric.RoundingPolicy) {
+				is.Equal(policy.Round(123456), 123000.0)
+				is.Equal(policy.Round(-98765), -98800.0)
+			},
+		},
+		{
+			name:   "round_significant_zero",
+			policy: metric.RoundSignificant(3),
+			check: func(is *isser.I, policy metric.RoundingPolicy) {
+				is.Equal(policy.Round(0), 0.0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
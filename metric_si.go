@@ -10,24 +10,27 @@ type SIBaseUnit interface {
 var (
 	SISystemOfUnits = NewSystemOfUnits("SI", "BIPM")
 
-	Meter = newSIBaseUnit(
+	// NonSISystemOfUnits groups units that are not part of the SI but are commonly used alongside it.
+	NonSISystemOfUnits = NewSystemOfUnits("Non-SI", "BIPM")
+
+	Meter = WithPlural(newSIBaseUnit(
 		"meter",
 		"The meter is the length of the path travelled by light in vacuum during a time interval of 1/299792458 of a second",
 		"m",
 		SISystemOfUnits,
-	)
-	Radian = NewDerivedUnit(
+	), "meters")
+	Radian = WithPlural(NewDerivedUnit(
 		"radian",
 		"The radian is the SI unit for measuring angles, and is the standard unit of angular measure used in many areas of mathematics",
 		"rad",
 		SISystemOfUnits,
-	)
-	Steradian = NewDerivedUnit(
+	), "radians")
+	Steradian = WithPlural(NewDerivedUnit(
 		"steradian",
 		"The steradian is the SI unit of solid angle. It is used to describe two-dimensional angles, analogous to the way in which the radian describes angles in three dimensions",
 		"sr",
 		SISystemOfUnits,
-	)
+	), "steradians")
 	Area = NewDerivedUnit(
 		"area",
 		"The area is the quantity that expresses the extent of a two-dimensional figure or shape, or planar lamina, in the plane",
@@ -42,18 +45,25 @@ var (
 		SISystemOfUnits,
 		NewDerivedUnitTerm(Meter, 3),
 	)
-	Kilogram = newSIBaseUnit(
+	Kilogram = WithPlural(newSIBaseUnit(
 		"kilogram",
 		"The kilogram is the unit of mass; it is equal to the mass of the international prototype of the kilogram",
 		"kg",
 		SISystemOfUnits,
-	)
-	Second = newSIBaseUnit(
+	), "kilograms")
+	// Gram is the unit the prefixes of mass apply to, e.g. the milligram; the kilogram is its kilo form.
+	Gram = WithPlural(NewBaseUnit(
+		"gram",
+		"The gram is the unit of mass equal to one thousandth of a kilogram",
+		"g",
+		SISystemOfUnits,
+	), "grams")
+	Second = WithPlural(newSIBaseUnit(
 		"second",
 		"The second is the duration of 9192631770 periods of the radiation corresponding to the transition between the two hyperfine levels of the ground state of the caesium 133 atom",
 		"s",
 		SISystemOfUnits,
-	)
+	), "seconds")
 	Speed = NewDerivedUnit(
 		"speed",
 		"The speed is the rate of change of distance with time",
@@ -62,13 +72,47 @@ var (
 		NewDerivedUnitTerm(Meter, 1),
 		NewDerivedUnitTerm(Second, -1),
 	)
-	Ampere = newSIBaseUnit(
+	Hertz = NewDerivedUnit(
+		"hertz",
+		"The hertz is the SI derived unit of frequency; it is equal to one cycle per second",
+		"Hz",
+		SISystemOfUnits,
+		NewDerivedUnitTerm(Second, -1),
+	)
+	Ampere = WithPlural(newSIBaseUnit(
 		"ampere",
 		"The ampere is that constant current which, if maintained in two straight parallel conductors of infinite length, of negligible circular cross-section, and placed 1 meter apart in vacuum, would produce between these conductors a force equal to 2 x 10-7 newton per meter of length",
 		"A",
 		SISystemOfUnits,
-	)
-	Watt = NewDerivedUnit(
+	), "amperes")
+	Newton = WithPlural(NewDerivedUnit(
+		"newton",
+		"The newton is the SI derived unit of force; it is the force accelerating a mass of 1 kilogram by 1 meter per second squared",
+		"N",
+		SISystemOfUnits,
+		NewDerivedUnitTerm(Meter, 1),
+		NewDerivedUnitTerm(Kilogram, 1),
+		NewDerivedUnitTerm(Second, -2),
+	), "newtons")
+	Pascal = WithPlural(NewDerivedUnit(
+		"pascal",
+		"The pascal is the SI derived unit of pressure; it is equal to 1 newton per square meter",
+		"Pa",
+		SISystemOfUnits,
+		NewDerivedUnitTerm(Kilogram, 1),
+		NewDerivedUnitTerm(Meter, -1),
+		NewDerivedUnitTerm(Second, -2),
+	), "pascals")
+	Joule = WithPlural(NewDerivedUnit(
+		"joule",
+		"The joule is the SI derived unit of energy; it is equal to the work done by a force of 1 newton acting over 1 meter, or to 1 watt of power over 1 second",
+		"J",
+		SISystemOfUnits,
+		NewDerivedUnitTerm(Meter, 2),
+		NewDerivedUnitTerm(Kilogram, 1),
+		NewDerivedUnitTerm(Second, -2),
+	), "joules")
+	Watt = WithPlural(NewDerivedUnit(
 		"watt",
 		"The watt is the SI derived unit for power in the International System of Units (SI); it is defined as 1 joule per second and is used to quantify the rate of energy transfer",
 		"W",
@@ -76,13 +120,23 @@ var (
 		NewDerivedUnitTerm(Meter, 2),
 		NewDerivedUnitTerm(Kilogram, 1),
 		NewDerivedUnitTerm(Second, -3),
-	)
-	Kelvin = newSIBaseUnit(
+	), "watts")
+	Volt = WithPlural(NewDerivedUnit(
+		"volt",
+		"The volt is the SI derived unit of electric potential; it is the difference of electric potential between two points of a conductor carrying a constant current of 1 ampere when the power dissipated between these points is equal to 1 watt",
+		"V",
+		SISystemOfUnits,
+		NewDerivedUnitTerm(Meter, 2),
+		NewDerivedUnitTerm(Kilogram, 1),
+		NewDerivedUnitTerm(Second, -3),
+		NewDerivedUnitTerm(Ampere, -1),
+	), "volts")
+	Kelvin = WithPlural(newSIBaseUnit(
 		"kelvin",
 		"The kelvin, unit of thermodynamic temperature, is the fraction 1/273.16 of the thermodynamic temperature of the triple point of water",
 		"K",
 		SISystemOfUnits,
-	)
+	), "kelvins")
 	Celsius = NewDerivedUnit(
 		"celsius",
 		"The degree Celsius is the unit of temperature defined by the equation T(°C) = T(K) - 273.15",
@@ -90,26 +144,26 @@ var (
 		SISystemOfUnits,
 		NewDerivedUnitTerm(Kelvin, 1),
 	)
-	Mole = newSIBaseUnit(
+	Mole = WithPlural(newSIBaseUnit(
 		"mole",
 		"The mole is the amount of substance of a system which contains as many elementary entities as there are atoms in 0.012 kilogram of carbon 12",
 		"mol",
 		SISystemOfUnits,
-	)
-	Candela = newSIBaseUnit(
+	), "moles")
+	Candela = WithPlural(newSIBaseUnit(
 		"candela",
 		"The candela is the luminous intensity, in a given direction, of a source that emits monochromatic radiation of frequency 540 x 1012 hertz and that has a radiant intensity in that direction of 1/683 watt per steradian",
 		"cd",
 		SISystemOfUnits,
-	)
-	Lumen = NewDerivedUnit(
+	), "candelas")
+	Lumen = WithPlural(NewDerivedUnit(
 		"lumen",
 		"The lumen is the SI derived unit of luminous flux, a measure of the total quantity of visible light emitted by a source per unit of time",
 		"lm",
 		SISystemOfUnits,
 		NewDerivedUnitTerm(Candela, 1),
 		NewDerivedUnitTerm(Steradian, 1),
-	)
+	), "lumens")
 	Lux = NewDerivedUnit(
 		"lux",
 		"The lux is the SI unit of illuminance and luminous emittance, measuring luminous flux per unit area",
@@ -122,6 +176,7 @@ var (
 
 type siBaseUnitImpl struct {
 	name          string
+	plural        string
 	definition    string
 	symbol        string
 	systemOfUnits SystemOfUnits
@@ -143,6 +198,17 @@ func (s *siBaseUnitImpl) Name() string {
 	return s.name
 }
 
+func (s *siBaseUnitImpl) PluralName() string {
+	if s.plural == "" {
+		return s.name
+	}
+	return s.plural
+}
+
+func (s *siBaseUnitImpl) setPlural(plural string) {
+	s.plural = plural
+}
+
 func (s *siBaseUnitImpl) Definition() string {
 	return s.definition
 }
@@ -0,0 +1,339 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrPrefixNotSupported = errors.New("unit does not support SI prefixes")
+)
+
+// SIPrefix is a decimal multiple or submultiple of a unit, e.g. kilo (10³).
+type SIPrefix struct {
+	Name     string
+	Symbol   string
+	Exponent int
+}
+
+var (
+	Quecto = SIPrefix{Name: "quecto", Symbol: "q", Exponent: -30}
+	Ronto  = SIPrefix{Name: "ronto", Symbol: "r", Exponent: -27}
+	Yocto  = SIPrefix{Name: "yocto", Symbol: "y", Exponent: -24}
+	Zepto  = SIPrefix{Name: "zepto", Symbol: "z", Exponent: -21}
+	Atto   = SIPrefix{Name: "atto", Symbol: "a", Exponent: -18}
+	Femto  = SIPrefix{Name: "femto", Symbol: "f", Exponent: -15}
+	Pico   = SIPrefix{Name: "pico", Symbol: "p", Exponent: -12}
+	Nano   = SIPrefix{Name: "nano", Symbol: "n", Exponent: -9}
+	Micro  = SIPrefix{Name: "micro", Symbol: "µ", Exponent: -6}
+	Milli  = SIPrefix{Name: "milli", Symbol: "m", Exponent: -3}
+	Centi  = SIPrefix{Name: "centi", Symbol: "c", Exponent: -2}
+	Deci   = SIPrefix{Name: "deci", Symbol: "d", Exponent: -1}
+	Deca   = SIPrefix{Name: "deca", Symbol: "da", Exponent: 1}
+	Hecto  = SIPrefix{Name: "hecto", Symbol: "h", Exponent: 2}
+	Kilo   = SIPrefix{Name: "kilo", Symbol: "k", Exponent: 3}
+	Mega   = SIPrefix{Name: "mega", Symbol: "M", Exponent: 6}
+	Giga   = SIPrefix{Name: "giga", Symbol: "G", Exponent: 9}
+	Tera   = SIPrefix{Name: "tera", Symbol: "T", Exponent: 12}
+	Peta   = SIPrefix{Name: "peta", Symbol: "P", Exponent: 15}
+	Exa    = SIPrefix{Name: "exa", Symbol: "E", Exponent: 18}
+	Zetta  = SIPrefix{Name: "zetta", Symbol: "Z", Exponent: 21}
+	Yotta  = SIPrefix{Name: "yotta", Symbol: "Y", Exponent: 24}
+	Ronna  = SIPrefix{Name: "ronna", Symbol: "R", Exponent: 27}
+	Quetta = SIPrefix{Name: "quetta", Symbol: "Q", Exponent: 30}
+
+	// SIPrefixes lists all SI prefixes in ascending order.
+	SIPrefixes = []SIPrefix{
+		Quecto, Ronto, Yocto, Zepto, Atto, Femto, Pico, Nano, Micro, Milli, Centi, Deci,
+		Deca, Hecto, Kilo, Mega, Giga, Tera, Peta, Exa, Zetta, Yotta, Ronna, Quetta,
+	}
+)
+
+// prefixedUnit records the unprefixed unit and the prefix exponent of a Unit supporting SI prefixes.
+type prefixedUnit struct {
+	base     Unit
+	exponent int
+}
+
+var (
+	prefixMu sync.RWMutex
+	// prefixedUnits maps every prefixable unit to the prefixed units created so far, by prefix exponent;
+	// 0 is the unit itself. The other prefixed units are created by Prefixed on first use.
+	prefixedUnits = make(map[Unit]map[int]Unit)
+	// prefixesOf is the reverse of prefixedUnits.
+	prefixesOf = make(map[Unit]prefixedUnit)
+	// prefixableUnits lists the keys of prefixedUnits in the order of registration.
+	prefixableUnits []Unit
+)
+
+func init() {
+	// Units that already exist stand in for their prefixed forms; the kilogram makes the gram prefixable.
+	registerPrefixable(Gram, map[int]Unit{Kilo.Exponent: Kilogram})
+	registerPrefixable(Second, map[int]Unit{Nano.Exponent: Nanosecond, Micro.Exponent: Microsecond, Milli.Exponent: Millisecond})
+
+	for _, unit := range SISystemOfUnits.Units() {
+		if _, ok := prefixesOf[unit]; !ok && acceptsPrefixes(unit) {
+			registerPrefixable(unit, nil)
+		}
+	}
+}
+
+// acceptsPrefixes returns true if a prefix applies to the unit as a whole. It does not to units written as
+// the product of their terms, such as m² or m/s, nor to units that are another form of a single unit,
+// such as the degree Celsius of the kelvin.
+func acceptsPrefixes(unit Unit) bool {
+	derived, ok := unit.(DerivedUnit)
+	if !ok {
+		return true
+	}
+
+	terms := derived.Terms()
+	if len(terms) == 1 && terms[0].Exponent() == 1 {
+		return false
+	}
+	return !isProduct(derived)
+}
+
+// registerPrefixable makes the base accept SI prefixes, with existing units as some of its prefixed forms by exponent.
+func registerPrefixable(base Unit, existing map[int]Unit) {
+	units := map[int]Unit{0: base}
+	for exponent, unit := range existing {
+		units[exponent] = unit
+	}
+
+	for exponent, unit := range units {
+		prefixesOf[unit] = prefixedUnit{base: base, exponent: exponent}
+	}
+	prefixedUnits[base] = units
+	prefixableUnits = append(prefixableUnits, base)
+}
+
+// prefixInfo returns the unprefixed unit and the prefix exponent of a unit supporting SI prefixes.
+func prefixInfo(unit Unit) (prefixedUnit, bool) {
+	prefixMu.RLock()
+	defer prefixMu.RUnlock()
+
+	info, ok := prefixesOf[unit]
+	return info, ok
+}
+
+// createdPrefixed returns the prefixed form of the base with the exponent if it exists already.
+func createdPrefixed(base Unit, exponent int) (Unit, bool) {
+	prefixMu.RLock()
+	defer prefixMu.RUnlock()
+
+	unit, ok := prefixedUnits[base][exponent]
+	return unit, ok
+}
+
+func newPrefixedUnit(prefix SIPrefix, base Unit) Unit {
+	return WithPlural(NewDerivedUnit(
+		prefix.Name+base.Name(),
+		fmt.Sprintf("The %s%s is equal to 10^%d %s", prefix.Name, base.Name(), prefix.Exponent, base.Name()),
+		prefix.Symbol+base.Symbol(),
+		nil,
+		NewDerivedUnitTerm(base, 1),
+	), prefix.Name+pluralName(base))
+}
+
+// prefixConversion converts between units of which at least one supports SI prefixes through their unprefixed units:
+// by a power of ten between forms of the same unit, e.g. km to mm, and otherwise with a registered conversion
+// between forms of the unprefixed units, e.g. ks to min, kK to °C or, through the kilogram, mg to lb.
+func prefixConversion(sourceUnit, targetUnit Unit) (StandardConversion, bool) {
+	source, sourceOK := prefixInfo(sourceUnit)
+	if !sourceOK {
+		source = prefixedUnit{base: sourceUnit}
+	}
+	target, targetOK := prefixInfo(targetUnit)
+	if !targetOK {
+		target = prefixedUnit{base: targetUnit}
+	}
+	if !sourceOK && !targetOK {
+		return StandardConversion{}, false
+	}
+
+	if source.base == target.base {
+		ratio := powerOfTen(sourceUnit, source.exponent-target.exponent)
+		return StandardConversion{
+			conversionFn: func(quantity Quantity) (Quantity, error) {
+				return NewQuantity(quantity.Amount()*ratio.numerator/ratio.denominator, targetUnit), nil
+			},
+			sourceUnit: sourceUnit,
+			targetUnit: targetUnit,
+		}, true
+	}
+
+	between, from, to, ok := conversionBetweenForms(source.base, target.base)
+	if !ok {
+		return StandardConversion{}, false
+	}
+
+	toFrom, fromTo := powerOfTen(sourceUnit, source.exponent-from.exponent), powerOfTen(targetUnit, target.exponent-to.exponent)
+	return StandardConversion{
+		conversionFn: func(quantity Quantity) (Quantity, error) {
+			converted, err := between.Convert(NewQuantity(quantity.Amount()*toFrom.numerator/toFrom.denominator, from.base))
+			if err != nil {
+				return nil, err
+			}
+			return NewQuantity(converted.Amount()*fromTo.denominator/fromTo.numerator, targetUnit), nil
+		},
+		sourceUnit: sourceUnit,
+		targetUnit: targetUnit,
+	}, true
+}
+
+// conversionBetweenForms returns a registered conversion between forms of the unprefixed units, with the form it converts
+// from and to as the base and its prefix exponent. The unprefixed units are preferred, then the prefixed forms that
+// existed before, such as the kilogram, which stands in for the gram in the conversions of mass.
+func conversionBetweenForms(source, target Unit) (StandardConversion, prefixedUnit, prefixedUnit, bool) {
+	sources, targets := formsOf(source), formsOf(target)
+
+	for _, from := range sources {
+		for _, to := range targets {
+			if conversion, ok := UnitConverter.lookup(from.base, to.base); ok {
+				return conversion, from, to, true
+			}
+		}
+	}
+
+	return StandardConversion{}, prefixedUnit{}, prefixedUnit{}, false
+}
+
+// formsOf returns the unit and its prefixed forms created so far, the unit first, by ascending prefix exponent.
+// A unit that does not support prefixes is its only form.
+func formsOf(unit Unit) []prefixedUnit {
+	prefixMu.RLock()
+	defer prefixMu.RUnlock()
+
+	forms := []prefixedUnit{{base: unit}}
+	for _, exponent := range sortedExponents(prefixedUnits[unit]) {
+		if exponent != 0 {
+			forms = append(forms, prefixedUnit{base: prefixedUnits[unit][exponent], exponent: exponent})
+		}
+	}
+	return forms
+}
+
+func sortedExponents(units map[int]Unit) []int {
+	exponents := make([]int, 0, len(units))
+	for exponent := range units {
+		exponents = append(exponents, exponent)
+	}
+	sort.Ints(exponents)
+	return exponents
+}
+
+// powerOfTen describes the unit as 10^exponent base units, keeping the ratio exact.
+func powerOfTen(unit Unit, exponent int) linearUnit {
+	if exponent < 0 {
+		return linearUnit{unit, 1, math.Pow(10, float64(-exponent))}
+	}
+	return linearUnit{unit, math.Pow(10, float64(exponent)), 1}
+}
+
+// SupportsPrefixes returns true if the Unit, or the unit it is a prefixed form of, accepts SI prefixes.
+// These are the gram and the SI units except the degree Celsius, and area, volume and speed,
+// whose prefixes would not apply to the whole unit.
+func SupportsPrefixes(unit Unit) bool {
+	_, ok := prefixInfo(unit)
+	return ok
+}
+
+// Prefixed returns the unit with the given SI prefix, e.g. Kilo and Meter give the kilometer.
+// The returned units are shared, so that quantities in them can be compared and converted.
+func Prefixed(prefix SIPrefix, unit Unit) (Unit, error) {
+	info, ok := prefixInfo(unit)
+	if !ok || info.exponent != 0 {
+		return nil, fmt.Errorf("%w: %s", ErrPrefixNotSupported, unit)
+	}
+	if !isSIPrefix(prefix) {
+		return nil, fmt.Errorf("%w: %s%s", ErrPrefixNotSupported, prefix.Symbol, unit)
+	}
+
+	if prefixed, ok := createdPrefixed(unit, prefix.Exponent); ok {
+		return prefixed, nil
+	}
+
+	prefixMu.Lock()
+	defer prefixMu.Unlock()
+
+	if prefixed, ok := prefixedUnits[unit][prefix.Exponent]; ok {
+		return prefixed, nil
+	}
+
+	prefixed := newPrefixedUnit(prefix, unit)
+	prefixedUnits[unit][prefix.Exponent] = prefixed
+	prefixesOf[prefixed] = prefixedUnit{base: unit, exponent: prefix.Exponent}
+
+	return prefixed, nil
+}
+
+func isSIPrefix(prefix SIPrefix) bool {
+	for _, p := range SIPrefixes {
+		if p == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// Unprefixed returns the unit without its SI prefix and the prefix, e.g. Meter and Kilo for the kilometer.
+// It returns false if the unit is not a prefixed form of a unit supporting prefixes.
+func Unprefixed(unit Unit) (Unit, SIPrefix, bool) {
+	info, ok := prefixInfo(unit)
+	if !ok || info.exponent == 0 {
+		return nil, SIPrefix{}, false
+	}
+
+	for _, prefix := range SIPrefixes {
+		if prefix.Exponent == info.exponent {
+			return info.base, prefix, true
+		}
+	}
+
+	return nil, SIPrefix{}, false
+}
+
+// AutoPrefix expresses the Quantity with the prefix whose exponent is a multiple of three
+// that brings its absolute amount into [1, 1000), e.g. 0.00042 m becomes 420 µm.
+// Amounts beyond the range of the prefixes keep the smallest or largest prefix, and zero uses the unprefixed unit.
+func AutoPrefix(quantity Quantity) (Quantity, error) {
+	unit, ok := quantity.Metric().(Unit)
+	if !ok {
+		return nil, ErrMetricIsNotUnit
+	}
+
+	info, ok := prefixInfo(unit)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPrefixNotSupported, unit)
+	}
+
+	amount := quantity.Amount() * math.Pow(10, float64(info.exponent))
+
+	exponent := 0
+	if amount != 0 && !math.IsInf(amount, 0) && !math.IsNaN(amount) {
+		exponent = engineeringExponent(amount)
+		exponent = max(exponent, SIPrefixes[0].Exponent)
+		exponent = min(exponent, SIPrefixes[len(SIPrefixes)-1].Exponent)
+	}
+
+	for _, prefix := range SIPrefixes {
+		if prefix.Exponent == exponent {
+			target, err := Prefixed(prefix, info.base)
+			if err != nil {
+				return nil, err
+			}
+			return UnitConverter.Convert(quantity, target)
+		}
+	}
+	return UnitConverter.Convert(quantity, info.base)
+}
+
+// engineeringExponent returns the largest multiple of three not greater than the decimal exponent of f.
+func engineeringExponent(f float64) int {
+	exponent := int(math.Floor(math.Log10(math.Abs(f))))
+	return int(math.Floor(float64(exponent)/3)) * 3
+}
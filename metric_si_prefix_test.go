@@ -0,0 +1,212 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestPrefixed(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(is *isser.I)
+	}{
+		{
+			name: "Kilometer",
+			check: func(is *isser.I) {
+				kilometer, err := metric.Prefixed(metric.Kilo, metric.Meter)
+				is.NoErr(err)
+				is.Equal(kilometer.Symbol(), "km")
+				is.Equal(kilometer.Name(), "kilometer")
+
+				again, err := metric.Prefixed(metric.Kilo, metric.Meter)
+				is.NoErr(err)
+				is.Equal(again, kilometer)
+
+				converted, err := metric.UnitConverter.Convert(metric.NewQuantity(1.5, kilometer), metric.Meter)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 1500.0)
+			},
+		},
+		{
+			name: "BetweenPrefixes",
+			check: func(is *isser.I) {
+				milliwatt, err := metric.Prefixed(metric.Milli, metric.Watt)
+				is.NoErr(err)
+				megawatt, err := metric.Prefixed(metric.Mega, metric.Watt)
+				is.NoErr(err)
+
+				converted, err := metric.UnitConverter.Convert(metric.NewQuantity(2, megawatt), milliwatt)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 2e9)
+			},
+		},
+		{
+			name: "Gram",
+			check: func(is *isser.I) {
+				kilogram, err := metric.Prefixed(metric.Kilo, metric.Gram)
+				is.NoErr(err)
+				is.Equal(kilogram, metric.Kilogram)
+
+				milligram, err := metric.Prefixed(metric.Milli, metric.Gram)
+				is.NoErr(err)
+				is.Equal(milligram.Symbol(), "mg")
+
+				converted, err := metric.UnitConverter.Convert(metric.NewQuantity(2.5, metric.Kilogram), milligram)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 2.5e6)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(1500, metric.Gram), metric.Kilogram)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 1.5)
+			},
+		},
+		{
+			name: "ThroughUnprefixedUnits",
+			check: func(is *isser.I) {
+				kilosecond, err := metric.Prefixed(metric.Kilo, metric.Second)
+				is.NoErr(err)
+				converted, err := metric.UnitConverter.Convert(metric.NewQuantity(3.6, kilosecond), metric.Hour)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 1.0)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(30, metric.Minute), kilosecond)
+				is.NoErr(err)
+				is.Equal(converted.Amount(), 1.8)
+
+				millikelvin, err := metric.Prefixed(metric.Milli, metric.Kelvin)
+				is.NoErr(err)
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(273_150, millikelvin), metric.Celsius)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 9), 0.0)
+
+				_, err = metric.UnitConverter.Convert(metric.NewQuantity(1, kilosecond), metric.Meter)
+				is.True(errors.Is(err, metric.ErrNoConversion))
+			},
+		},
+		{
+			name: "ThroughKilogram",
+			check: func(is *isser.I) {
+				pound := metric.NewBaseUnit("pound", "The pound is equal to 0.45359237 kilograms", "lb", nil)
+				metric.NewStandardConversion(pound, metric.Kilogram, func(quantity metric.Quantity) (metric.Quantity, error) {
+					return metric.NewQuantity(quantity.Amount()*0.45359237, metric.Kilogram), nil
+				})
+				metric.NewStandardConversion(metric.Kilogram, pound, func(quantity metric.Quantity) (metric.Quantity, error) {
+					return metric.NewQuantity(quantity.Amount()/0.45359237, pound), nil
+				})
+				milligram, err := metric.Prefixed(metric.Milli, metric.Gram)
+				is.NoErr(err)
+				megagram, err := metric.Prefixed(metric.Mega, metric.Gram)
+				is.NoErr(err)
+
+				converted, err := metric.UnitConverter.Convert(metric.NewQuantity(1, pound), metric.Gram)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 9), 453.59237)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(453.59237, metric.Gram), pound)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 9), 1.0)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(1, pound), milligram)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 6), 453592.37)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(453592.37, milligram), pound)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 9), 1.0)
+
+				converted, err = metric.UnitConverter.Convert(metric.NewQuantity(0.45359237, megagram), pound)
+				is.NoErr(err)
+				is.Equal(roundTo(converted.Amount(), 9), 1000.0)
+			},
+		},
+		{
+			name: "ExistingTimeUnits",
+			check: func(is *isser.I) {
+				millisecond, err := metric.Prefixed(metric.Milli, metric.Second)
+				is.NoErr(err)
+				is.Equal(millisecond, metric.Millisecond)
+			},
+		},
+		{
+			name: "NotSupported",
+			check: func(is *isser.I) {
+				_, err := metric.Prefixed(metric.Kilo, metric.Kilogram)
+				is.True(errors.Is(err, metric.ErrPrefixNotSupported))
+
+				_, err = metric.Prefixed(metric.Kilo, metric.Byte)
+				is.True(errors.Is(err, metric.ErrPrefixNotSupported))
+
+				_, err = metric.Prefixed(metric.Kilo, metric.Millisecond)
+				is.True(errors.Is(err, metric.ErrPrefixNotSupported))
+
+				is.True(!metric.SupportsPrefixes(metric.Celsius))
+				is.True(!metric.SupportsPrefixes(metric.Speed))
+				is.True(!metric.SupportsPrefixes(metric.Area))
+				is.True(metric.SupportsPrefixes(metric.Hertz))
+				is.True(metric.SupportsPrefixes(metric.Millisecond))
+			},
+		},
+		{
+			name: "Unprefixed",
+			check: func(is *isser.I) {
+				base, prefix, ok := metric.Unprefixed(metric.Millisecond)
+				is.True(ok)
+				is.Equal(base, metric.Second)
+				is.Equal(prefix, metric.Milli)
+
+				_, _, ok = metric.Unprefixed(metric.Second)
+				is.True(!ok)
+
+				base, prefix, ok = metric.Unprefixed(metric.Kilogram)
+				is.True(ok)
+				is.Equal(base, metric.Gram)
+				is.Equal(prefix, metric.Kilo)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(isser.New(t))
+		})
+	}
+}
+
+func TestAutoPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		amount   float64
+		symbol   string
+	}{
+		{name: "Micrometer", quantity: metric.NewQuantity(0.00042, metric.Meter), amount: 420, symbol: "µm"},
+		{name: "Kilowatt", quantity: metric.NewQuantity(-1500, metric.Watt), amount: -1.5, symbol: "kW"},
+		{name: "Unprefixed", quantity: metric.NewQuantity(12, metric.Volt), amount: 12, symbol: "V"},
+		{name: "FromPrefixed", quantity: metric.NewQuantity(4200, metric.Millisecond), amount: 4.2, symbol: "s"},
+		{name: "Zero", quantity: metric.NewQuantity(0, metric.Ampere), amount: 0, symbol: "A"},
+		{name: "BeyondRange", quantity: metric.NewQuantity(1e33, metric.Meter), amount: 1000, symbol: "Qm"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			scaled, err := metric.AutoPrefix(tt.quantity)
+			is.NoErr(err)
+			is.Equal(roundTo(scaled.Amount(), 9), tt.amount)
+			is.Equal(scaled.Metric().Symbol(), tt.symbol)
+		})
+	}
+
+	t.Run("NotSupported", func(t *testing.T) {
+		is := isser.New(t)
+
+		_, err := metric.AutoPrefix(metric.NewQuantity(1500, metric.Byte))
+		is.True(errors.Is(err, metric.ErrPrefixNotSupported))
+	})
+}
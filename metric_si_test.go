@@ -12,7 +12,7 @@ func TestSiBaseUnit_SystemOfUnits(t *testing.T) {
 
 	units := metric.SISystemOfUnits.Units()
 
-	is.Equal(len(units), 16)
+	is.Equal(len(units), 22)
 
 	is.True(containsUnit(units, metric.Meter))
 	is.True(containsUnit(units, metric.Kilogram))
@@ -24,12 +24,18 @@ func TestSiBaseUnit_SystemOfUnits(t *testing.T) {
 	is.True(containsUnit(units, metric.Area))
 	is.True(containsUnit(units, metric.Volume))
 	is.True(containsUnit(units, metric.Speed))
+	is.True(containsUnit(units, metric.Joule))
 	is.True(containsUnit(units, metric.Celsius))
 	is.True(containsUnit(units, metric.Lux))
 	is.True(containsUnit(units, metric.Lumen))
 	is.True(containsUnit(units, metric.Steradian))
 	is.True(containsUnit(units, metric.Radian))
 	is.True(containsUnit(units, metric.Watt))
+	is.True(containsUnit(units, metric.Volt))
+	is.True(containsUnit(units, metric.Hertz))
+	is.True(containsUnit(units, metric.Newton))
+	is.True(containsUnit(units, metric.Pascal))
+	is.True(containsUnit(units, metric.Gram))
 }
 
 func TestSIBaseUnit_Methods(t *testing.T) {
@@ -19,6 +19,20 @@ func TestMetric(t *testing.T) {
 	is.Equal(m.String(), "m") // String() returns Symbol()
 }
 
+func TestBaseUnit(t *testing.T) {
+	is := isser.New(t)
+
+	s := metric.NewSystemOfUnits("Test System", "Test Body")
+	u := metric.NewBaseUnit("Token", "A single token", "tok", s)
+
+	is.Equal(u.Name(), "Token")
+	is.Equal(u.Definition(), "A single token")
+	is.Equal(u.Symbol(), "tok")
+	is.Equal(u.String(), "tok")
+	is.Equal(u.SystemOfUnits(), s)
+	is.Equal(len(s.Units()), 1)
+}
+
 func TestSystemOfUnits(t *testing.T) {
 	is := isser.New(t)
 
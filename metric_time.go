@@ -0,0 +1,80 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var (
+	ErrDurationOverflow = errors.New("quantity does not fit in time.Duration")
+)
+
+var (
+	Nanosecond  = newTimeUnit("nanosecond", "The nanosecond is one billionth of a second", "ns")
+	Microsecond = newTimeUnit("microsecond", "The microsecond is one millionth of a second", "µs")
+	Millisecond = newTimeUnit("millisecond", "The millisecond is one thousandth of a second", "ms")
+	Minute      = newTimeUnit("minute", "The minute is a unit of time equal to 60 seconds", "min")
+	Hour        = newTimeUnit("hour", "The hour is a unit of time equal to 60 minutes", "h")
+	Day         = newTimeUnit("day", "The day is a unit of time equal to 24 hours", "d")
+	Week        = newTimeUnit("week", "The week is a unit of time equal to 7 days", "wk")
+)
+
+func init() {
+	newLinearConversions(
+		linearUnit{Second, 1, 1},
+		linearUnit{Nanosecond, 1, 1e9},
+		linearUnit{Microsecond, 1, 1e6},
+		linearUnit{Millisecond, 1, 1e3},
+		linearUnit{Minute, 60, 1},
+		linearUnit{Hour, 3_600, 1},
+		linearUnit{Day, 86_400, 1},
+		linearUnit{Week, 604_800, 1},
+	)
+}
+
+// newTimeUnit creates a multiple of the second without terms, as described on FindDerivedUnit.
+func newTimeUnit(name, definition, symbol string) Unit {
+	return WithPlural(NewBaseUnit(name, definition, symbol, NonSISystemOfUnits), name+"s")
+}
+
+// FromDuration returns the time.Duration as a Quantity in Seconds.
+func FromDuration(d time.Duration) Quantity {
+	return NewQuantity(d.Seconds(), Second)
+}
+
+// ToDuration converts a time Quantity into a time.Duration rounded to the nearest nanosecond.
+func ToDuration(quantity Quantity) (time.Duration, error) {
+	nanoseconds, err := UnitConverter.Convert(quantity, Nanosecond)
+	if err != nil {
+		return 0, err
+	}
+
+	amount := math.Round(nanoseconds.Amount())
+	if amount >= math.MaxInt64 || amount < math.MinInt64 || math.IsNaN(amount) {
+		return 0, fmt.Errorf("%w: %s", ErrDurationOverflow, quantity)
+	}
+
+	return time.Duration(amount), nil
+}
+
+// Rate divides the Quantity by the elapsed time, e.g. bytes transferred since time.Now() was taken.
+// If a registered unit matches the resulting terms (e.g. BytePerSecond for Byte), the result is expressed in it,
+// so that it can be converted further with the UnitConverter.
+func Rate(quantity Quantity, elapsed time.Duration) (Quantity, error) {
+	if elapsed == 0 {
+		return nil, ErrDivisionByZero
+	}
+
+	rate, err := quantity.DivideBy(FromDuration(elapsed))
+	if err != nil {
+		return nil, err
+	}
+
+	if unit, ok := FindDerivedUnit(NewDerivedUnitTerm(quantity.Metric(), 1), NewDerivedUnitTerm(Second, -1)); ok {
+		return NewQuantity(rate.Amount(), unit), nil
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,101 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestTimeConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   metric.Unit
+		expected float64
+	}{
+		{name: "MinuteToSecond", quantity: metric.NewQuantity(1.5, metric.Minute), target: metric.Second, expected: 90},
+		{name: "HourToMinute", quantity: metric.NewQuantity(2, metric.Hour), target: metric.Minute, expected: 120},
+		{name: "WeekToDay", quantity: metric.NewQuantity(2, metric.Week), target: metric.Day, expected: 14},
+		{name: "MillisecondToMicrosecond", quantity: metric.NewQuantity(3, metric.Millisecond), target: metric.Microsecond, expected: 3000},
+		{name: "NanosecondToSecond", quantity: metric.NewQuantity(250, metric.Nanosecond), target: metric.Second, expected: 0.00000025},
+		{name: "DayToHour", quantity: metric.NewQuantity(1, metric.Day), target: metric.Hour, expected: 24},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, tt.target)
+			is.NoErr(err)
+			is.Equal(converted.Amount(), tt.expected)
+			is.Equal(converted.Metric(), tt.target)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	is := isser.New(t)
+
+	q := metric.FromDuration(1500 * time.Millisecond)
+	is.Equal(q.Amount(), 1.5)
+	is.Equal(q.Metric(), metric.Second)
+
+	d, err := metric.ToDuration(metric.NewQuantity(90, metric.Minute))
+	is.NoErr(err)
+	is.Equal(d, 90*time.Minute)
+
+	d, err = metric.ToDuration(metric.NewQuantity(1.5, metric.Microsecond))
+	is.NoErr(err)
+	is.Equal(d, 1500*time.Nanosecond)
+
+	d, err = metric.ToDuration(q)
+	is.NoErr(err)
+	is.Equal(d, 1500*time.Millisecond)
+
+	_, err = metric.ToDuration(metric.NewQuantity(1e6, metric.Week))
+	is.True(errors.Is(err, metric.ErrDurationOverflow))
+
+	_, err = metric.ToDuration(metric.NewQuantity(1, metric.Meter))
+	is.Equal(err, metric.ErrNoConversion)
+}
+
+func TestRate(t *testing.T) {
+	is := isser.New(t)
+
+	rate, err := metric.Rate(metric.NewQuantity(5_000_000, metric.Byte), 2*time.Second)
+	is.NoErr(err)
+	is.Equal(rate.Amount(), 2_500_000.0)
+	is.Equal(rate.Metric(), metric.BytePerSecond)
+
+	mbps, err := metric.UnitConverter.Convert(rate, metric.MegabitPerSecond)
+	is.NoErr(err)
+	is.Equal(mbps.Amount(), 20.0)
+
+	speed, err := metric.Rate(metric.NewQuantity(100, metric.Meter), 10*time.Second)
+	is.NoErr(err)
+	is.Equal(speed.Metric(), metric.Speed)
+
+	custom, err := metric.Rate(metric.NewQuantity(10, metric.Kilogram), 5*time.Second)
+	is.NoErr(err)
+	is.Equal(custom.Amount(), 2.0)
+	is.Equal(custom.Metric().Symbol(), "kg/s")
+
+	_, err = metric.Rate(metric.NewQuantity(10, metric.Byte), 0)
+	is.Equal(err, metric.ErrDivisionByZero)
+}
+
+func TestTimeUnitsHideNoScale(t *testing.T) {
+	is := isser.New(t)
+
+	unit, ok := metric.FindDerivedUnit(metric.NewDerivedUnitTerm(metric.Second, 1))
+	is.True(!ok || unit != metric.Minute)
+
+	for _, unit := range []metric.Unit{metric.Nanosecond, metric.Millisecond, metric.Minute, metric.Hour, metric.Day, metric.Week} {
+		_, derived := unit.(metric.DerivedUnit)
+		is.True(!derived)
+	}
+}
@@ -3,6 +3,8 @@ package metric
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sync"
 )
 
 var (
@@ -17,6 +19,7 @@ var (
 )
 
 type defaultUnitConverter struct {
+	mu          sync.RWMutex
 	conversions map[Unit]map[Unit]StandardConversion
 }
 
@@ -40,21 +43,49 @@ func (c *defaultUnitConverter) Convert(quantity Quantity, target Unit) (Quantity
 		return nil, fmt.Errorf("%w: metric %q is not a unit", ErrMetricIsNotUnit, quantity.Metric().Name())
 	}
 
+	if unit == target {
+		if measured, ok := quantity.(MeasuredQuantity); ok {
+			return NewMeasuredQuantity(measured.Amount(), measured.Uncertainty(), target), nil
+		}
+		return NewQuantity(quantity.Amount(), target), nil
+	}
+
 	conversion, err := c.getConversion(unit, target)
 	if err != nil {
 		return nil, err
 	}
 
+	if measured, ok := quantity.(MeasuredQuantity); ok {
+		return convertMeasured(measured, conversion.Convert)
+	}
+
 	return conversion.Convert(quantity)
 }
 
 func (c *defaultUnitConverter) getConversion(sourceUnit, targetUnit Unit) (StandardConversion, error) {
-	conversion, ok := c.conversions[targetUnit][sourceUnit]
-	if !ok {
-		return StandardConversion{}, ErrNoConversion
+	if conversion, ok := c.lookup(sourceUnit, targetUnit); ok {
+		return conversion, nil
+	}
+	if conversion, ok := prefixConversion(sourceUnit, targetUnit); ok {
+		return conversion, nil
+	}
+	if conversion, ok := dimensionlessConversion(sourceUnit, targetUnit); ok {
+		return conversion, nil
 	}
+	if conversion, ok := termConversion(sourceUnit, targetUnit); ok {
+		return conversion, nil
+	}
+
+	return StandardConversion{}, ErrNoConversion
+}
+
+// lookup returns the conversion registered from the source to the target unit.
+func (c *defaultUnitConverter) lookup(sourceUnit, targetUnit Unit) (StandardConversion, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 
-	return conversion, nil
+	conversion, ok := c.conversions[targetUnit][sourceUnit]
+	return conversion, ok
 }
 
 type StandardConversion struct {
@@ -73,6 +104,9 @@ func NewStandardConversion(sourceUnit, targetUnit Unit, conversionFn func(Quanti
 		targetUnit:   targetUnit,
 	}
 
+	UnitConverter.mu.Lock()
+	defer UnitConverter.mu.Unlock()
+
 	if _, ok := UnitConverter.conversions[targetUnit]; !ok {
 		UnitConverter.conversions[targetUnit] = make(map[Unit]StandardConversion)
 	}
@@ -94,3 +128,96 @@ func (c StandardConversion) Convert(source Quantity) (Quantity, error) {
 
 	return NewQuantity(convertedAmount.Amount(), c.targetUnit), nil
 }
+
+// linearUnit describes a Unit as an exact ratio of a common base unit:
+// one unit equals numerator/denominator base units.
+type linearUnit struct {
+	unit        Unit
+	numerator   float64
+	denominator float64
+}
+
+// newLinearConversions registers conversions between every pair of the given units.
+// Ratios are kept as fractions so that e.g. 23% converts to exactly 0.23.
+func newLinearConversions(units ...linearUnit) {
+	for _, source := range units {
+		for _, target := range units {
+			if source.unit == target.unit {
+				continue
+			}
+
+			source, target := source, target
+			NewStandardConversion(source.unit, target.unit, func(quantity Quantity) (Quantity, error) {
+				amount := quantity.Amount() * (source.numerator * target.denominator) / (source.denominator * target.numerator)
+				return NewQuantity(amount, target.unit), nil
+			})
+		}
+	}
+}
+
+// termConversion converts between products of units term by term, e.g. mg/dL to g/L, if every term of the source
+// converts to a term of the target with the same exponent by a factor, without an offset such as that of the degree Celsius.
+func termConversion(sourceUnit, targetUnit Unit) (StandardConversion, bool) {
+	source, target := ExpandTerms(sourceUnit, 1), ExpandTerms(targetUnit, 1)
+	// A single term is the unit itself, which has no other conversions.
+	if len(source) != len(target) || len(source) == 0 || len(source) == 1 && source[0].Exponent() == 1 {
+		return StandardConversion{}, false
+	}
+
+	factor := 1.0
+	used := make([]bool, len(target))
+	for _, s := range source {
+		found := false
+		for i, t := range target {
+			if used[i] || s.Exponent() != t.Exponent() {
+				continue
+			}
+			if f, ok := linearFactor(s.Metric(), t.Metric()); ok {
+				factor *= math.Pow(f, float64(s.Exponent()))
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return StandardConversion{}, false
+		}
+	}
+
+	return StandardConversion{
+		conversionFn: func(quantity Quantity) (Quantity, error) {
+			return NewQuantity(quantity.Amount()*factor, targetUnit), nil
+		},
+		sourceUnit: sourceUnit,
+		targetUnit: targetUnit,
+	}, true
+}
+
+// linearFactor returns the factor converting the source to the target unit, if the conversion has no offset.
+func linearFactor(source, target Metric) (float64, bool) {
+	if source == target {
+		return 1, true
+	}
+
+	sourceUnit, ok := source.(Unit)
+	if !ok {
+		return 0, false
+	}
+	targetUnit, ok := target.(Unit)
+	if !ok {
+		return 0, false
+	}
+
+	conversion, err := UnitConverter.getConversion(sourceUnit, targetUnit)
+	if err != nil {
+		return 0, false
+	}
+	zero, err := conversion.Convert(NewQuantity(0, sourceUnit))
+	if err != nil || zero.Amount() != 0 {
+		return 0, false
+	}
+	one, err := conversion.Convert(NewQuantity(1, sourceUnit))
+	if err != nil {
+		return 0, false
+	}
+	return one.Amount(), true
+}
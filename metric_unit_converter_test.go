@@ -37,3 +37,23 @@ func TestDirectUnitConverter(t *testing.T) {
 	is.True(c2.Metric() == metric.Celsius)
 	is.True(c2.Amount() == celsius.Amount())
 }
+
+func TestTermUnitConverter(t *testing.T) {
+	is := isser.New(t)
+
+	kilometer, err := metric.Prefixed(metric.Kilo, metric.Meter)
+	is.NoErr(err)
+	kilometersPerHour := metric.DivideUnits(kilometer, metric.Hour)
+
+	speed, err := metric.UnitConverter.Convert(metric.NewQuantity(36, kilometersPerHour), metric.Speed)
+	is.NoErr(err)
+	is.Equal(roundTo(speed.Amount(), 9), 10.0)
+
+	back, err := metric.UnitConverter.Convert(speed, kilometersPerHour)
+	is.NoErr(err)
+	is.Equal(roundTo(back.Amount(), 9), 36.0)
+
+	// The offset of the degree Celsius rules out converting its products term by term.
+	_, err = metric.UnitConverter.Convert(metric.NewQuantity(1, metric.DivideUnits(metric.Celsius, metric.Second)), metric.DivideUnits(metric.Kelvin, metric.Second))
+	is.True(err != nil)
+}
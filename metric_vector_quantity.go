@@ -0,0 +1,259 @@
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+var ErrVectorDimension = errors.New("invalid vector dimension")
+
+// VectorQuantity is a vector of N components sharing a Metric, e.g. a position of (1, 2, 3) m or a force of (0, 0, -9.81) N.
+type VectorQuantity interface {
+	Metric() Metric
+	String() string
+
+	// Dimension returns the number of components.
+	Dimension() int
+
+	// Components returns a copy of the amounts of the components.
+	Components() []float64
+
+	// Component returns the i-th component as a Quantity.
+	// Returns ErrVectorDimension if i is not an index of a component.
+	Component(i int) (Quantity, error)
+
+	// Add adds two VectorQuantity objects component-wise
+	// Precondition: both vectors must have the same Dimension and Metric
+	Add(VectorQuantity) (VectorQuantity, error)
+
+	// Subtract subtracts one VectorQuantity object from another component-wise
+	// Precondition: both vectors must have the same Dimension and Metric
+	Subtract(VectorQuantity) (VectorQuantity, error)
+
+	// Multiply multiplies every component by the multiplier
+	Multiply(multiplier float64) (VectorQuantity, error)
+
+	// MultiplyBy multiplies every component by the scalar Quantity, e.g. a velocity by a duration
+	// The Metric of the returned vector is a DerivedUnit T*P as given by Quantity.MultiplyBy
+	MultiplyBy(multiplier Quantity) (VectorQuantity, error)
+
+	// Divide divides every component by the divisor
+	Divide(divisor float64) (VectorQuantity, error)
+
+	// Dot returns the scalar product of two vectors of the same Dimension
+	// The Metric of the returned Quantity is a DerivedUnit T*P as given by Quantity.MultiplyBy, e.g. N*m for a force and a displacement
+	Dot(VectorQuantity) (Quantity, error)
+
+	// Cross returns the vector product of two three-dimensional vectors
+	// The Metric of the returned vector is a DerivedUnit T*P as given by Quantity.MultiplyBy
+	Cross(VectorQuantity) (VectorQuantity, error)
+
+	// Magnitude returns the Euclidean norm of the vector in its Metric
+	Magnitude() Quantity
+
+	// Normalize returns the dimensionless unit vector in the direction of the vector
+	Normalize() (VectorQuantity, error)
+
+	// Equals compares two VectorQuantity objects component-wise
+	// Precondition: both vectors must have the same Dimension and Metric
+	Equals(VectorQuantity) (bool, error)
+}
+
+type vectorQuantityImpl struct {
+	components []float64
+	metric     Metric
+}
+
+// NewVectorQuantity creates a VectorQuantity of the components in the metric.
+func NewVectorQuantity(metric Metric, components ...float64) VectorQuantity {
+	return &vectorQuantityImpl{
+		components: append([]float64(nil), components...),
+		metric:     metric,
+	}
+}
+
+func (v *vectorQuantityImpl) Metric() Metric {
+	return v.metric
+}
+
+func (v *vectorQuantityImpl) String() string {
+	components := make([]string, len(v.components))
+	for i, c := range v.components {
+		components[i] = fmt.Sprintf("%v", c)
+	}
+	return fmt.Sprintf("(%s) %s", strings.Join(components, ", "), v.metric)
+}
+
+func (v *vectorQuantityImpl) Dimension() int {
+	return len(v.components)
+}
+
+func (v *vectorQuantityImpl) Components() []float64 {
+	return append([]float64(nil), v.components...)
+}
+
+func (v *vectorQuantityImpl) Component(i int) (Quantity, error) {
+	if i < 0 || i >= len(v.components) {
+		return nil, fmt.Errorf("%w: no component %d of %d", ErrVectorDimension, i, len(v.components))
+	}
+	return NewQuantity(v.components[i], v.metric), nil
+}
+
+// compatible checks that both vectors have the same Dimension and Metric.
+func (v *vectorQuantityImpl) compatible(v2 VectorQuantity) error {
+	if v.metric != v2.Metric() {
+		return ErrIncompatibleMetric{v.metric, v2.Metric()}
+	}
+	if len(v.components) != v2.Dimension() {
+		return fmt.Errorf("%w: %d and %d components", ErrVectorDimension, len(v.components), v2.Dimension())
+	}
+	return nil
+}
+
+func (v *vectorQuantityImpl) Add(v2 VectorQuantity) (VectorQuantity, error) {
+	if err := v.compatible(v2); err != nil {
+		return nil, err
+	}
+	if IsLevel(v.metric) {
+		return nil, fmt.Errorf("%w: cannot add to %s", ErrLogarithmicArithmetic, v)
+	}
+
+	components := v2.Components()
+	for i, c := range v.components {
+		components[i] = c + components[i]
+	}
+	return &vectorQuantityImpl{components, v.metric}, nil
+}
+
+func (v *vectorQuantityImpl) Subtract(v2 VectorQuantity) (VectorQuantity, error) {
+	if err := v.compatible(v2); err != nil {
+		return nil, err
+	}
+	if IsLevel(v.metric) {
+		return nil, fmt.Errorf("%w: cannot subtract from %s", ErrLogarithmicArithmetic, v)
+	}
+
+	components := v2.Components()
+	for i, c := range v.components {
+		components[i] = c - components[i]
+	}
+	return &vectorQuantityImpl{components, v.metric}, nil
+}
+
+func (v *vectorQuantityImpl) Multiply(multiplier float64) (VectorQuantity, error) {
+	if IsLevel(v.metric) {
+		return nil, fmt.Errorf("%w: cannot multiply %s", ErrLogarithmicArithmetic, v)
+	}
+	return v.scale(multiplier, v.metric), nil
+}
+
+func (v *vectorQuantityImpl) MultiplyBy(multiplier Quantity) (VectorQuantity, error) {
+	if isLogarithmic(v.metric) || isLogarithmic(multiplier.Metric()) {
+		return nil, fmt.Errorf("%w: cannot multiply %s by %s", ErrLogarithmicArithmetic, v, multiplier)
+	}
+	return v.scale(multiplier.Amount(), MultiplyUnits(v.metric, multiplier.Metric())), nil
+}
+
+func (v *vectorQuantityImpl) Divide(divisor float64) (VectorQuantity, error) {
+	if IsLevel(v.metric) {
+		return nil, fmt.Errorf("%w: cannot divide %s", ErrLogarithmicArithmetic, v)
+	}
+	if divisor == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return v.divide(divisor, v.metric), nil
+}
+
+func (v *vectorQuantityImpl) scale(factor float64, metric Metric) VectorQuantity {
+	components := make([]float64, len(v.components))
+	for i, c := range v.components {
+		components[i] = c * factor
+	}
+	return &vectorQuantityImpl{components, metric}
+}
+
+func (v *vectorQuantityImpl) divide(divisor float64, metric Metric) VectorQuantity {
+	components := make([]float64, len(v.components))
+	for i, c := range v.components {
+		components[i] = c / divisor
+	}
+	return &vectorQuantityImpl{components, metric}
+}
+
+func (v *vectorQuantityImpl) Dot(v2 VectorQuantity) (Quantity, error) {
+	if len(v.components) != v2.Dimension() {
+		return nil, fmt.Errorf("%w: %d and %d components", ErrVectorDimension, len(v.components), v2.Dimension())
+	}
+
+	var sum float64
+	for i, c := range v2.Components() {
+		sum += v.components[i] * c
+	}
+
+	return NewQuantity(sum, v.metric).MultiplyBy(NewQuantity(1, v2.Metric()))
+}
+
+func (v *vectorQuantityImpl) Cross(v2 VectorQuantity) (VectorQuantity, error) {
+	if len(v.components) != 3 || v2.Dimension() != 3 {
+		return nil, fmt.Errorf("%w: the cross product requires 3 components, got %d and %d", ErrVectorDimension, len(v.components), v2.Dimension())
+	}
+
+	if isLogarithmic(v.metric) || isLogarithmic(v2.Metric()) {
+		return nil, fmt.Errorf("%w: cannot multiply %s by %s", ErrLogarithmicArithmetic, v, v2)
+	}
+
+	a, b := v.components, v2.Components()
+	return &vectorQuantityImpl{
+		components: []float64{
+			a[1]*b[2] - a[2]*b[1],
+			a[2]*b[0] - a[0]*b[2],
+			a[0]*b[1] - a[1]*b[0],
+		},
+		metric: MultiplyUnits(v.metric, v2.Metric()),
+	}, nil
+}
+
+func (v *vectorQuantityImpl) Magnitude() Quantity {
+	var sum float64
+	for _, c := range v.components {
+		sum += c * c
+	}
+	return NewQuantity(math.Sqrt(sum), v.metric)
+}
+
+func (v *vectorQuantityImpl) Normalize() (VectorQuantity, error) {
+	magnitude := v.Magnitude().Amount()
+	if magnitude == 0 {
+		return nil, fmt.Errorf("%w: cannot normalize the zero vector", ErrDivisionByZero)
+	}
+	return v.divide(magnitude, One), nil
+}
+
+func (v *vectorQuantityImpl) Equals(v2 VectorQuantity) (bool, error) {
+	if err := v.compatible(v2); err != nil {
+		return false, err
+	}
+
+	for i, c := range v2.Components() {
+		if v.components[i] != c {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ConvertVector converts every component of the vector to the target unit.
+func (c *defaultUnitConverter) ConvertVector(vector VectorQuantity, target Unit) (VectorQuantity, error) {
+	components := vector.Components()
+	for i, component := range components {
+		converted, err := c.Convert(NewQuantity(component, vector.Metric()), target)
+		if err != nil {
+			return nil, err
+		}
+		components[i] = converted.Amount()
+	}
+
+	return &vectorQuantityImpl{components, target}, nil
+}
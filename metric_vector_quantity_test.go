@@ -0,0 +1,187 @@
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	isser "github.com/matryer/is"
+)
+
+func TestVectorQuantity(t *testing.T) {
+	tests := []struct {
+		name   string
+		v1, v2 metric.VectorQuantity
+		check  func(is *isser.I, v1, v2 metric.VectorQuantity)
+	}{
+		{
+			name: "String",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2.5, -3),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				is.Equal(v1.String(), "(1, 2.5, -3) m")
+				is.Equal(v1.Dimension(), 3)
+
+				component, err := v1.Component(1)
+				is.NoErr(err)
+				is.Equal(component.String(), "2.5 m")
+
+				_, err = v1.Component(3)
+				is.True(errors.Is(err, metric.ErrVectorDimension))
+				_, err = v1.Component(-1)
+				is.True(errors.Is(err, metric.ErrVectorDimension))
+			},
+		},
+		{
+			name: "Add",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2, 3),
+			v2:   metric.NewVectorQuantity(metric.Meter, 4, 5, 6),
+			check: func(is *isser.I, v1, v2 metric.VectorQuantity) {
+				sum, err := v1.Add(v2)
+				is.NoErr(err)
+				is.Equal(sum.Components(), []float64{5, 7, 9})
+				is.Equal(sum.Metric(), metric.Meter)
+
+				difference, err := v2.Subtract(v1)
+				is.NoErr(err)
+				is.Equal(difference.Components(), []float64{3, 3, 3})
+			},
+		},
+		{
+			name: "AddIncompatible",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2, 3),
+			v2:   metric.NewVectorQuantity(metric.Second, 1, 2, 3),
+			check: func(is *isser.I, v1, v2 metric.VectorQuantity) {
+				_, err := v1.Add(v2)
+				var incompatible metric.ErrIncompatibleMetric
+				is.True(errors.As(err, &incompatible))
+
+				_, err = v1.Subtract(metric.NewVectorQuantity(metric.Meter, 1, 2))
+				is.True(errors.Is(err, metric.ErrVectorDimension))
+			},
+		},
+		{
+			name: "Multiply",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, -2, 3),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				product, err := v1.Multiply(2)
+				is.NoErr(err)
+				is.Equal(product.Components(), []float64{2, -4, 6})
+
+				quotient, err := v1.Divide(2)
+				is.NoErr(err)
+				is.Equal(quotient.Components(), []float64{0.5, -1, 1.5})
+
+				_, err = v1.Divide(0)
+				is.True(errors.Is(err, metric.ErrDivisionByZero))
+			},
+		},
+		{
+			name: "MultiplyBy",
+			v1:   metric.NewVectorQuantity(metric.Speed, 1, 2, 0),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				displacement, err := v1.MultiplyBy(metric.NewQuantity(10, metric.Second))
+				is.NoErr(err)
+				is.Equal(displacement.Components(), []float64{10, 20, 0})
+				is.Equal(displacement.Metric().Symbol(), "m/s*s")
+			},
+		},
+		{
+			name: "Dot",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2, 3),
+			v2:   metric.NewVectorQuantity(metric.Second, 4, -5, 6),
+			check: func(is *isser.I, v1, v2 metric.VectorQuantity) {
+				dot, err := v1.Dot(v2)
+				is.NoErr(err)
+				is.Equal(dot.Amount(), 12.0)
+				is.Equal(dot.Metric().Symbol(), "m*s")
+
+				terms := dot.Metric().(metric.DerivedUnit).Terms()
+				is.Equal(terms[0].Metric(), metric.Meter)
+				is.Equal(terms[1].Metric(), metric.Second)
+
+				_, err = v1.Dot(metric.NewVectorQuantity(metric.Second, 1, 2))
+				is.True(errors.Is(err, metric.ErrVectorDimension))
+			},
+		},
+		{
+			name: "Cross",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 0, 0),
+			v2:   metric.NewVectorQuantity(metric.Meter, 0, 1, 0),
+			check: func(is *isser.I, v1, v2 metric.VectorQuantity) {
+				cross, err := v1.Cross(v2)
+				is.NoErr(err)
+				is.Equal(cross.Components(), []float64{0, 0, 1})
+				is.Equal(cross.Metric().Symbol(), "m*m")
+
+				cross, err = v2.Cross(v1)
+				is.NoErr(err)
+				is.Equal(cross.Components(), []float64{0, 0, -1})
+
+				_, err = metric.NewVectorQuantity(metric.Meter, 1, 2).Cross(metric.NewVectorQuantity(metric.Meter, 3, 4))
+				is.True(errors.Is(err, metric.ErrVectorDimension))
+			},
+		},
+		{
+			name: "Magnitude",
+			v1:   metric.NewVectorQuantity(metric.Meter, 3, 4),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				is.Equal(v1.Magnitude().String(), "5 m")
+
+				unit, err := v1.Normalize()
+				is.NoErr(err)
+				is.Equal(unit.Components(), []float64{0.6, 0.8})
+				is.Equal(unit.Metric(), metric.One)
+
+				_, err = metric.NewVectorQuantity(metric.Meter, 0, 0).Normalize()
+				is.True(errors.Is(err, metric.ErrDivisionByZero))
+			},
+		},
+		{
+			name: "Equals",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2),
+			v2:   metric.NewVectorQuantity(metric.Meter, 1, 2),
+			check: func(is *isser.I, v1, v2 metric.VectorQuantity) {
+				equal, err := v1.Equals(v2)
+				is.NoErr(err)
+				is.True(equal)
+
+				equal, err = v1.Equals(metric.NewVectorQuantity(metric.Meter, 1, 3))
+				is.NoErr(err)
+				is.True(!equal)
+
+				_, err = v1.Equals(metric.NewVectorQuantity(metric.Second, 1, 2))
+				is.True(err != nil)
+			},
+		},
+		{
+			name: "Immutable",
+			v1:   metric.NewVectorQuantity(metric.Meter, 1, 2),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				components := v1.Components()
+				components[0] = 100
+				is.Equal(v1.Components(), []float64{1, 2})
+			},
+		},
+		{
+			name: "ConvertVector",
+			v1:   metric.NewVectorQuantity(metric.Hour, 1, 0.5),
+			check: func(is *isser.I, v1, _ metric.VectorQuantity) {
+				converted, err := metric.UnitConverter.ConvertVector(v1, metric.Minute)
+				is.NoErr(err)
+				is.Equal(converted.Components(), []float64{60, 30})
+				is.Equal(converted.Metric(), metric.Minute)
+
+				_, err = metric.UnitConverter.ConvertVector(v1, metric.Meter)
+				is.True(errors.Is(err, metric.ErrNoConversion))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			tt.check(is, tt.v1, tt.v2)
+		})
+	}
+}
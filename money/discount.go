@@ -0,0 +1,345 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrInvalidDiscount = errors.New("invalid discount")
+)
+
+// DiscountStage describes when a Discount is applied relative to Tax.
+type DiscountStage int
+
+const (
+	// PreTax discounts reduce the taxable amount.
+	PreTax DiscountStage = iota
+	// PostTax discounts reduce the amount after all taxes were added.
+	PostTax
+)
+
+func (s DiscountStage) String() string {
+	switch s {
+	case PreTax:
+		return "pre-tax"
+	case PostTax:
+		return "post-tax"
+	default:
+		return fmt.Sprintf("DiscountStage(%d)", int(s))
+	}
+}
+
+// DiscountTier is a single step of a tiered volume Discount.
+// The tier applies when the purchased quantity is at least MinQuantity.
+type DiscountTier struct {
+	MinQuantity int64
+	Percents    float64
+}
+
+// Discount describes a price reduction applied to a line of identical items.
+type Discount struct {
+	name          string
+	kind          string
+	stage         DiscountStage
+	limit         *Money
+	allowNegative bool
+
+	// amountOffFn returns how much should be taken off the base Money together with the reason.
+	amountOffFn func(base, unitPrice Money, quantity int64) (Money, string, error)
+}
+
+// NewPercentageDiscount creates a Discount taking the given percents off the price.
+func NewPercentageDiscount(name string, percents float64) Discount {
+	return Discount{
+		name: name,
+		kind: fmt.Sprintf("%g%%", percents),
+		amountOffFn: func(base, _ Money, _ int64) (Money, string, error) {
+			if percents < 0 || percents > 100 {
+				return Money{}, "", fmt.Errorf("%w: percentage %g out of range", ErrInvalidDiscount, percents)
+			}
+
+			off, err := base.Multiply(percents / 100.0)
+			if err != nil {
+				return Money{}, "", err
+			}
+
+			return off, fmt.Sprintf("%g%% off %s", percents, base), nil
+		},
+	}
+}
+
+// NewFixedDiscount creates a Discount taking a fixed amount of Money off the price.
+func NewFixedDiscount(name string, amount Money) Discount {
+	return Discount{
+		name: name,
+		kind: amount.String(),
+		amountOffFn: func(base, _ Money, _ int64) (Money, string, error) {
+			if amount.amount.IsNeg() {
+				return Money{}, "", fmt.Errorf("%w: negative fixed amount %s", ErrInvalidDiscount, amount)
+			}
+			if base.currency != amount.currency {
+				return Money{}, "", fmt.Errorf("%w: currency %s does not match %s", ErrInvalidDiscount, amount.currency.Code(), base.currency.Code())
+			}
+
+			return amount, fmt.Sprintf("%s off", amount), nil
+		},
+	}
+}
+
+// NewBuyXGetYDiscount creates a Discount giving get items for free for every buy+get items purchased.
+// The free items are valued at the unit price.
+func NewBuyXGetYDiscount(name string, buy, get int64) Discount {
+	return Discount{
+		name: name,
+		kind: fmt.Sprintf("buy %d get %d", buy, get),
+		amountOffFn: func(_, unitPrice Money, quantity int64) (Money, string, error) {
+			if buy <= 0 || get <= 0 {
+				return Money{}, "", fmt.Errorf("%w: buy %d get %d", ErrInvalidDiscount, buy, get)
+			}
+
+			free := (quantity / (buy + get)) * get
+
+			off, err := unitPrice.Multiply(float64(free))
+			if err != nil {
+				return Money{}, "", err
+			}
+
+			return off, fmt.Sprintf("%d of %d items free", free, quantity), nil
+		},
+	}
+}
+
+// NewTieredDiscount creates a volume Discount choosing the tier with the highest MinQuantity
+// not exceeding the purchased quantity.
+func NewTieredDiscount(name string, tiers ...DiscountTier) Discount {
+	sorted := make([]DiscountTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinQuantity < sorted[j].MinQuantity
+	})
+
+	return Discount{
+		name: name,
+		kind: "tiered",
+		amountOffFn: func(base, _ Money, quantity int64) (Money, string, error) {
+			var tier *DiscountTier
+			for i := range sorted {
+				if sorted[i].MinQuantity <= quantity {
+					tier = &sorted[i]
+				}
+			}
+			if tier == nil {
+				return NewMoney(0, base.currency), fmt.Sprintf("quantity %d below first tier", quantity), nil
+			}
+			if tier.Percents < 0 || tier.Percents > 100 {
+				return Money{}, "", fmt.Errorf("%w: percentage %g out of range", ErrInvalidDiscount, tier.Percents)
+			}
+
+			off, err := base.Multiply(tier.Percents / 100.0)
+			if err != nil {
+				return Money{}, "", err
+			}
+
+			return off, fmt.Sprintf("%g%% off for %d or more items", tier.Percents, tier.MinQuantity), nil
+		},
+	}
+}
+
+// WithStage returns a copy of the Discount applied at the given DiscountStage.
+func (d Discount) WithStage(stage DiscountStage) Discount {
+	d.stage = stage
+	return d
+}
+
+// WithCap returns a copy of the Discount that never takes off more than limit.
+func (d Discount) WithCap(limit Money) Discount {
+	d.limit = &limit
+	return d
+}
+
+// AllowNegative returns a copy of the Discount that is allowed to bring the total below zero.
+func (d Discount) AllowNegative() Discount {
+	d.allowNegative = true
+	return d
+}
+
+func (d Discount) Name() string {
+	return d.name
+}
+
+func (d Discount) Stage() DiscountStage {
+	return d.stage
+}
+
+func (d Discount) String() string {
+	return fmt.Sprintf("%s (%s)", d.name, d.kind)
+}
+
+// Calculate returns how much is taken off the price of quantity items at unitPrice.
+func (d Discount) Calculate(unitPrice Money, quantity int64) (Money, error) {
+	subtotal, err := unitPrice.Multiply(float64(quantity))
+	if err != nil {
+		return Money{}, err
+	}
+
+	adj, err := d.apply(subtotal, unitPrice, quantity)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return adj.Amount.negate(), nil
+}
+
+// apply calculates the Adjustment of the Discount for the given base amount.
+func (d Discount) apply(base, unitPrice Money, quantity int64) (Adjustment, error) {
+	off, reason, err := d.amountOffFn(base, unitPrice, quantity)
+	if err != nil {
+		return Adjustment{}, fmt.Errorf("discount %q: %w", d.name, err)
+	}
+	off = off.round()
+
+	if d.limit != nil {
+		if d.limit.currency != off.currency {
+			return Adjustment{}, fmt.Errorf("discount %q: %w: cap currency %s does not match %s", d.name, ErrInvalidDiscount, d.limit.currency.Code(), off.currency.Code())
+		}
+		if d.limit.amount.Less(off.amount) {
+			off = *d.limit
+			reason = fmt.Sprintf("%s, capped at %s", reason, d.limit)
+		}
+	}
+
+	if !d.allowNegative && base.amount.Less(off.amount) {
+		off = base
+		if base.amount.IsNeg() {
+			off = NewMoney(0, base.currency)
+		}
+		reason = fmt.Sprintf("%s, limited to keep total non-negative", reason)
+	}
+
+	return Adjustment{
+		Name:   d.name,
+		Kind:   DiscountAdjustment,
+		Amount: off.negate(),
+		Reason: reason,
+	}, nil
+}
+
+// AfterDiscount returns Money reduced by the Discount, treating the Money as a single item.
+func (m Money) AfterDiscount(d Discount) (Money, error) {
+	adj, err := d.apply(m, m, 1)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return m.Add(adj.Amount)
+}
+
+// Adjustment is a single entry of a PriceBreakdown, describing what was applied and why.
+// Discounts have negative amounts, taxes positive ones.
+type Adjustment struct {
+	Name   string
+	Kind   AdjustmentKind
+	Amount Money
+	Reason string
+}
+
+// AdjustmentKind tells whether an Adjustment comes from a Discount or a Tax.
+type AdjustmentKind string
+
+const (
+	DiscountAdjustment AdjustmentKind = "discount"
+	TaxAdjustment      AdjustmentKind = "tax"
+)
+
+func (a Adjustment) String() string {
+	return fmt.Sprintf("%s: %s (%s)", a.Name, a.Amount, a.Reason)
+}
+
+// PriceBreakdown describes how the Total was calculated from the Subtotal.
+type PriceBreakdown struct {
+	Subtotal    Money
+	Adjustments []Adjustment
+	Total       Money
+}
+
+// CalculatePrice applies discounts and taxes to quantity items at unitPrice.
+// PreTax discounts are applied first, in the given order, each on the running total.
+// Every Tax is then calculated on the discounted amount, and finally PostTax discounts are applied.
+func CalculatePrice(unitPrice Money, quantity int64, discounts []Discount, taxes []Tax) (PriceBreakdown, error) {
+	subtotal, err := unitPrice.Multiply(float64(quantity))
+	if err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	breakdown := PriceBreakdown{
+		Subtotal: subtotal,
+		Total:    subtotal,
+	}
+
+	applyStage := func(stage DiscountStage) error {
+		for _, d := range discounts {
+			if d.stage != stage {
+				continue
+			}
+
+			adj, err := d.apply(breakdown.Total, unitPrice, quantity)
+			if err != nil {
+				return err
+			}
+
+			total, err := breakdown.Total.Add(adj.Amount)
+			if err != nil {
+				return fmt.Errorf("discount %q: %w", d.name, err)
+			}
+
+			breakdown.Total = total
+			breakdown.Adjustments = append(breakdown.Adjustments, adj)
+		}
+		return nil
+	}
+
+	if err := applyStage(PreTax); err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	taxable := breakdown.Total
+	for _, t := range taxes {
+		amount := t.Calculate(taxable).round()
+
+		total, err := breakdown.Total.Add(amount)
+		if err != nil {
+			return PriceBreakdown{}, fmt.Errorf("tax %s: %w", t, err)
+		}
+
+		breakdown.Total = total
+		breakdown.Adjustments = append(breakdown.Adjustments, Adjustment{
+			Name:   t.Type().Name(),
+			Kind:   TaxAdjustment,
+			Amount: amount,
+			Reason: fmt.Sprintf("%s of %s", t, taxable),
+		})
+	}
+
+	if err := applyStage(PostTax); err != nil {
+		return PriceBreakdown{}, err
+	}
+
+	return breakdown, nil
+}
+
+// round rounds the Money to the number of decimal places of its Currency.
+func (m Money) round() Money {
+	return Money{
+		amount:   m.amount.Round(m.currency.Decimal()),
+		currency: m.currency,
+	}
+}
+
+func (m Money) negate() Money {
+	return Money{
+		amount:   m.amount.Neg(),
+		currency: m.currency,
+	}
+}
@@ -0,0 +1,136 @@
+package money_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric/money"
+	isser "github.com/matryer/is"
+)
+
+func TestDiscount(t *testing.T) {
+	tests := []struct {
+		name     string
+		discount money.Discount
+		check    func(is *isser.I, discount money.Discount)
+	}{
+		{
+			name:     "Percentage",
+			discount: money.NewPercentageDiscount("Summer sale", 10),
+			check: func(is *isser.I, discount money.Discount) {
+				off, err := discount.Calculate(money.NewMoney(1999, money.USD), 2)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(400))
+				is.Equal(discount.String(), "Summer sale (10%)")
+			},
+		},
+		{
+			name:     "Fixed",
+			discount: money.NewFixedDiscount("Coupon", money.NewMoney(500, money.USD)),
+			check: func(is *isser.I, discount money.Discount) {
+				off, err := discount.Calculate(money.NewMoney(1000, money.USD), 1)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(500))
+			},
+		},
+		{
+			name:     "Fixed_IncompatibleCurrency",
+			discount: money.NewFixedDiscount("Coupon", money.NewMoney(500, money.PLN)),
+			check: func(is *isser.I, discount money.Discount) {
+				_, err := discount.Calculate(money.NewMoney(1000, money.USD), 1)
+				is.True(errors.Is(err, money.ErrInvalidDiscount))
+			},
+		},
+		{
+			name:     "BuyXGetY",
+			discount: money.NewBuyXGetYDiscount("3 for 2", 2, 1),
+			check: func(is *isser.I, discount money.Discount) {
+				off, err := discount.Calculate(money.NewMoney(300, money.USD), 7)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(600))
+			},
+		},
+		{
+			name: "Tiered",
+			discount: money.NewTieredDiscount("Volume",
+				money.DiscountTier{MinQuantity: 100, Percents: 10},
+				money.DiscountTier{MinQuantity: 10, Percents: 5},
+			),
+			check: func(is *isser.I, discount money.Discount) {
+				off, err := discount.Calculate(money.NewMoney(100, money.USD), 5)
+				is.NoErr(err)
+				is.True(off.IsZero())
+
+				off, err = discount.Calculate(money.NewMoney(100, money.USD), 20)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(100))
+
+				off, err = discount.Calculate(money.NewMoney(100, money.USD), 100)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(1000))
+			},
+		},
+		{
+			name:     "WithCap",
+			discount: money.NewPercentageDiscount("Half off", 50).WithCap(money.NewMoney(1000, money.USD)),
+			check: func(is *isser.I, discount money.Discount) {
+				off, err := discount.Calculate(money.NewMoney(5000, money.USD), 1)
+				is.NoErr(err)
+				is.Equal(off.MinorUnit(), uint64(1000))
+			},
+		},
+		{
+			name:     "NeverNegative",
+			discount: money.NewFixedDiscount("Gift card", money.NewMoney(5000, money.USD)),
+			check: func(is *isser.I, discount money.Discount) {
+				price, err := money.NewMoney(1000, money.USD).AfterDiscount(discount)
+				is.NoErr(err)
+				is.True(price.IsZero())
+
+				price, err = money.NewMoney(1000, money.USD).AfterDiscount(discount.AllowNegative())
+				is.NoErr(err)
+				is.Equal(price.MinorUnit(), uint64(4000))
+				lessThan, err := price.LessThan(money.NewMoney(0, money.USD))
+				is.NoErr(err)
+				is.True(lessThan)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			tt.check(is, tt.discount)
+		})
+	}
+}
+
+func TestCalculatePrice(t *testing.T) {
+	is := isser.New(t)
+
+	discounts := []money.Discount{
+		money.NewFixedDiscount("Loyalty", money.NewMoney(100, money.USD)).WithStage(money.PostTax),
+		money.NewPercentageDiscount("Summer sale", 10),
+	}
+	taxes := []money.Tax{money.NewTax(23, money.VAT)}
+
+	breakdown, err := money.CalculatePrice(money.NewMoney(1000, money.USD), 2, discounts, taxes)
+	is.NoErr(err)
+
+	// 20.00 - 10% = 18.00, + 23% VAT = 22.14, - 1.00 = 21.14
+	is.Equal(breakdown.Subtotal.MinorUnit(), uint64(2000))
+	is.Equal(breakdown.Total.MinorUnit(), uint64(2114))
+	is.Equal(len(breakdown.Adjustments), 3)
+
+	is.Equal(breakdown.Adjustments[0].Name, "Summer sale")
+	is.Equal(breakdown.Adjustments[0].Kind, money.DiscountAdjustment)
+	is.Equal(breakdown.Adjustments[0].Amount.MinorUnit(), uint64(200))
+
+	is.Equal(breakdown.Adjustments[1].Name, "VAT")
+	is.Equal(breakdown.Adjustments[1].Kind, money.TaxAdjustment)
+	is.Equal(breakdown.Adjustments[1].Amount.MinorUnit(), uint64(414))
+
+	is.Equal(breakdown.Adjustments[2].Name, "Loyalty")
+	is.Equal(breakdown.Adjustments[2].Amount.MinorUnit(), uint64(100))
+}
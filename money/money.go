@@ -1,12 +1,18 @@
 package money
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/internal/fmtstate"
 	"github.com/govalues/decimal"
 )
 
+var (
+	ErrInvalidSplit = errors.New("invalid number of parts")
+)
+
 // Money is a special monetary Quantity.
 type Money struct {
 	amount   decimal.Decimal
@@ -20,6 +26,20 @@ func NewMoney(minorUnit int64, currency Currency) Money {
 	}
 }
 
+// NewMoneyFromDecimal creates Money from an amount expressed in the major unit of the Currency, e.g. 19.99 for $19.99.
+// The amount is rescaled to the decimal places of the Currency, so 19.9 USD has 1990 minor units.
+func NewMoneyFromDecimal(amount decimal.Decimal, currency Currency) Money {
+	return Money{
+		amount:   amount.Rescale(currency.Decimal()),
+		currency: currency,
+	}
+}
+
+// Decimal returns the amount expressed in the major unit of the Currency.
+func (m Money) Decimal() decimal.Decimal {
+	return m.amount
+}
+
 // MinorUnit returns the amount in the smallest subdivision of the Currency.
 func (m Money) MinorUnit() uint64 {
 	return m.amount.Coef()
@@ -27,9 +47,48 @@ func (m Money) MinorUnit() uint64 {
 
 // String returns a formatted string representation of the Money object in the format "{amount} {currency code}"
 func (m Money) String() string {
-	w, f, _ := m.amount.Int64(m.currency.Decimal())
+	return m.amount.Rescale(m.currency.Decimal()).String() + " " + m.Currency().Code()
+}
+
+// Format implements fmt.Formatter.
+//
+// The %v and %s verbs print the same as String. The %f verb prints the amount with the precision, defaulting to
+// the decimal places of the Currency, and %e and %g format the amount as a float64. The width pads the whole output.
+// The '+' flag prints the currency name instead of its code, e.g. "12.00 US Dollar".
+func (m Money) Format(f fmt.State, verb rune) {
+	var amount string
+
+	switch verb {
+	case 'v', 's':
+		if !f.Flag('+') {
+			fmtstate.Pad(f, m.String())
+			return
+		}
+		amount = fmt.Sprintf("%.*f", m.currency.Decimal(), m.amount)
+	case 'f', 'F':
+		precision, ok := f.Precision()
+		if !ok {
+			precision = m.currency.Decimal()
+		}
+		amount = fmt.Sprintf("%.*f", precision, m.amount)
+	case 'e', 'E', 'g', 'G':
+		float, _ := m.amount.Float64()
+		if precision, ok := f.Precision(); ok {
+			amount = fmt.Sprintf("%.*"+string(verb), precision, float)
+		} else {
+			amount = fmt.Sprintf("%"+string(verb), float)
+		}
+	default:
+		fmt.Fprintf(f, "%%!%c(%s)", verb, m.String())
+		return
+	}
+
+	label := m.currency.Code()
+	if f.Flag('+') {
+		label = m.currency.Name()
+	}
 
-	return fmt.Sprintf("%d.%d %s", w, f, m.Currency().Code())
+	fmtstate.Pad(f, amount+" "+label)
 }
 
 // Metric returns the Currency associated with the Money object.
@@ -104,6 +163,22 @@ func (m Money) Multiply(multiplier float64) (Money, error) {
 	return Money{cents, m.currency}, nil
 }
 
+// Percent returns the given share of the Money object
+// Precondition: the rate must be a dimensionless Quantity, e.g. in metric.Percent or metric.BasisPoint
+// Returns a new Money object that has an amount equal to the amount of the target Money object multiplied by the rate
+func (m Money) Percent(rate metric.Quantity) (Money, error) {
+	if !metric.IsDimensionless(rate.Metric()) {
+		return Money{}, metric.ErrIncompatibleMetric{M1: rate.Metric(), M2: metric.One}
+	}
+
+	fraction, err := metric.UnitConverter.Convert(rate, metric.One)
+	if err != nil {
+		return Money{}, fmt.Errorf("converting rate %s: %w", rate, err)
+	}
+
+	return m.Multiply(fraction.Amount())
+}
+
 // Divide dividing the Money object by the divisor
 // Returns a new Money object that has an amount equal to the amount of the target Money object divided by the divisor
 func (m Money) Divide(divisor float64) (Money, error) {
@@ -123,6 +198,40 @@ func (m Money) Divide(divisor float64) (Money, error) {
 	}, nil
 }
 
+// Split splits the Money object into the given number of parts
+// Precondition: the number of parts must be positive
+// Returns Money objects rounded to the Currency that add up to the rounded target Money object and differ by at most one minor unit,
+// e.g. 100.00 USD split into 3 parts gives 33.34, 33.33 and 33.33 USD; the leftover minor units go to the first parts
+func (m Money) Split(parts int) ([]Money, error) {
+	if parts <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSplit, parts)
+	}
+
+	scale := m.currency.Decimal()
+	total := m.amount.Round(scale).Pad(scale)
+	share, leftover := total.Coef()/uint64(parts), total.Coef()%uint64(parts)
+
+	result := make([]Money, parts)
+	for i := range result {
+		minorUnits := share
+		if uint64(i) < leftover {
+			minorUnits++
+		}
+
+		amount, err := decimal.New(int64(minorUnits), scale)
+		if err != nil {
+			return nil, fmt.Errorf("splitting: %w", err)
+		}
+		if total.Sign() < 0 {
+			amount = amount.Neg()
+		}
+
+		result[i] = Money{amount, m.currency}
+	}
+
+	return result, nil
+}
+
 // DivideBy dividing the Money object by the divisor metric.Quantity object
 // Returns a new metric.Quantity object that has an amount equal to the amount of the target Money object divided by the amount of the parameter metric.Quantity object
 // The Metric of the returned metric.Quantity object is a metric.DerivedUnit given by the following equation: TP–1 where T is the metric.Unit of the target object and P is the metric.Unit of the parameter object
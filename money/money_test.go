@@ -1,11 +1,13 @@
 package money_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/IAmRadek/metric"
 	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
 	isser "github.com/matryer/is"
 )
 
@@ -120,6 +122,29 @@ func TestMoney(t *testing.T) {
 				).Symbol())
 			},
 		},
+		{
+			name: "Percent",
+			q1:   money.NewMoney(20000, money.USD),
+			q2:   money.NewMoney(50, money.USD),
+			check: func(is *isser.I, q1, q2 money.Money) {
+				fee, err := q1.Percent(metric.NewQuantity(25, metric.BasisPoint))
+				is.NoErr(err)
+				eq, err := fee.Equals(q2)
+				is.NoErr(err)
+				is.True(eq)
+
+				ratio, err := metric.NewQuantity(5, metric.Meter).DivideBy(metric.NewQuantity(2000, metric.Meter))
+				is.NoErr(err)
+				fee, err = q1.Percent(ratio)
+				is.NoErr(err)
+				eq, err = fee.Equals(q2)
+				is.NoErr(err)
+				is.True(eq)
+
+				_, err = q1.Percent(metric.NewQuantity(25, metric.Meter))
+				is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.Meter, M2: metric.One})
+			},
+		},
 		{
 			name: "AfterTax",
 			q1:   money.NewMoney(1000, money.USD),
@@ -153,6 +178,33 @@ func TestMoney(t *testing.T) {
 				is.True(eq)
 			},
 		},
+		{
+			name: "Split",
+			q1:   money.NewMoney(10000, money.USD),
+			check: func(is *isser.I, q1, _ money.Money) {
+				parts, err := q1.Split(3)
+				is.NoErr(err)
+				is.Equal(len(parts), 3)
+				is.Equal(fmt.Sprintf("%f %f %f", parts[0], parts[1], parts[2]), "33.34 USD 33.33 USD 33.33 USD")
+			},
+		},
+		{
+			name: "SplitNegative",
+			q1:   money.NewMoney(-101, money.EUR),
+			check: func(is *isser.I, q1, _ money.Money) {
+				parts, err := q1.Split(2)
+				is.NoErr(err)
+				is.Equal(fmt.Sprintf("%f %f", parts[0], parts[1]), "-0.51 EUR -0.50 EUR")
+			},
+		},
+		{
+			name: "SplitInvalid",
+			q1:   money.NewMoney(100, money.EUR),
+			check: func(is *isser.I, q1, _ money.Money) {
+				_, err := q1.Split(0)
+				is.True(errors.Is(err, money.ErrInvalidSplit))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -163,3 +215,57 @@ func TestMoney(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMoneyFromDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		currency  money.Currency
+		minorUnit uint64
+		expected  string
+	}{
+		{name: "Exact", amount: "19.99", currency: money.USD, minorUnit: 1999, expected: "19.99 USD"},
+		{name: "Padded", amount: "19.9", currency: money.USD, minorUnit: 1990, expected: "19.90 USD"},
+		{name: "Whole", amount: "19", currency: money.EUR, minorUnit: 1900, expected: "19.00 EUR"},
+		{name: "Rounded", amount: "19.995", currency: money.GBP, minorUnit: 2000, expected: "20.00 GBP"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			m := money.NewMoneyFromDecimal(decimal.MustParse(tt.amount), tt.currency)
+			is.Equal(m.MinorUnit(), tt.minorUnit)
+			is.Equal(m.String(), tt.expected)
+		})
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		money    money.Money
+		expected string
+	}{
+		{name: "Value", format: "%v", money: money.NewMoney(1250, money.USD), expected: "12.50 USD"},
+		{name: "String", format: "%s", money: money.NewMoney(1250, money.USD), expected: "12.50 USD"},
+		{name: "DefaultPrecision", format: "%f", money: money.NewMoney(1205, money.EUR), expected: "12.05 EUR"},
+		{name: "Precision", format: "%.1f", money: money.NewMoney(1249, money.EUR), expected: "12.5 EUR"},
+		{name: "Exponent", format: "%.2e", money: money.NewMoney(123456, money.PLN), expected: "1.23e+03 PLN"},
+		{name: "General", format: "%g", money: money.NewMoney(1250, money.PLN), expected: "12.5 PLN"},
+		{name: "Verbose", format: "%+v", money: money.NewMoney(1200, money.USD), expected: "12.00 US Dollar"},
+		{name: "Width", format: "%12.2f|", money: money.NewMoney(1250, money.GBP), expected: "   12.50 GBP|"},
+		{name: "LeftAligned", format: "%-12v|", money: money.NewMoney(1250, money.GBP), expected: "12.50 GBP   |"},
+		{name: "BadVerb", format: "%d", money: money.NewMoney(1250, money.GBP), expected: "%!d(12.50 GBP)"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(fmt.Sprintf(tt.format, tt.money), tt.expected)
+		})
+	}
+}
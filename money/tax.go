@@ -16,10 +16,30 @@ func NewTax(percents float64, taxType TaxType) Tax {
 	}
 }
 
+// NewTaxFromRate creates a Tax from a dimensionless rate Quantity, e.g. metric.NewQuantity(23, metric.Percent).
+func NewTaxFromRate(rate metric.Quantity, taxType TaxType) (Tax, error) {
+	if !metric.IsDimensionless(rate.Metric()) {
+		return Tax{}, metric.ErrIncompatibleMetric{M1: rate.Metric(), M2: metric.Percent}
+	}
+
+	percents, err := metric.UnitConverter.Convert(rate, metric.Percent)
+	if err != nil {
+		return Tax{}, fmt.Errorf("converting rate %s: %w", rate, err)
+	}
+
+	return NewTax(percents.Amount(), taxType), nil
+}
+
+// Rate returns the rate of the Tax in percents.
 func (t Tax) Rate() float64 {
 	return t.Amount()
 }
 
+// Percentage returns the rate of the Tax as a Quantity in metric.Percent.
+func (t Tax) Percentage() metric.Quantity {
+	return metric.NewQuantity(t.Rate(), metric.Percent)
+}
+
 func (t Tax) Type() TaxType {
 	return t.Metric().(TaxType)
 }
@@ -30,7 +50,7 @@ func (t Tax) String() string {
 
 // Calculate returns how much tax there is
 func (t Tax) Calculate(m Money) Money {
-	q, _ := m.Multiply(t.Rate() / 100.0)
+	q, _ := m.Percent(t.Percentage())
 
 	return q
 }
@@ -0,0 +1,292 @@
+package money
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrTaxRateNotFound     = errors.New("tax rate not found")
+	ErrOverlappingTaxRate  = errors.New("tax rate overlaps with an existing one")
+	ErrUnknownTaxType      = errors.New("unknown tax type")
+	ErrInvalidTaxRateTable = errors.New("invalid tax rate table")
+)
+
+// TaxCategory classifies goods and services subject to different rates of the same TaxType.
+type TaxCategory string
+
+const (
+	StandardRate TaxCategory = "standard"
+	ReducedRate  TaxCategory = "reduced"
+	ZeroRate     TaxCategory = "zero"
+	Exempt       TaxCategory = "exempt"
+)
+
+// TaxRate is the rate of a TaxType in a jurisdiction for a TaxCategory, effective between two dates.
+type TaxRate struct {
+	// Jurisdiction is an ISO 3166 country code, optionally followed by a region, e.g. "PL" or "US-CA".
+	Jurisdiction string
+	Type         TaxType
+	Category     TaxCategory
+	// Rate in percents.
+	Rate float64
+	// ValidFrom is the first day the rate is effective.
+	ValidFrom time.Time
+	// ValidTo is the last day the rate is effective, or zero if the rate is still in force.
+	ValidTo time.Time
+}
+
+// Tax returns the Tax described by the TaxRate.
+func (r TaxRate) Tax() Tax {
+	return NewTax(r.Rate, r.Type)
+}
+
+// EffectiveAt returns true if the TaxRate is in force on the day of t.
+func (r TaxRate) EffectiveAt(t time.Time) bool {
+	day := truncateToDay(t)
+	if day.Before(r.ValidFrom) {
+		return false
+	}
+
+	return r.ValidTo.IsZero() || !day.After(r.ValidTo)
+}
+
+func (r TaxRate) overlaps(other TaxRate) bool {
+	if r.Jurisdiction != other.Jurisdiction || r.Type != other.Type || r.Category != other.Category {
+		return false
+	}
+
+	endsBefore := func(a, b TaxRate) bool {
+		return !a.ValidTo.IsZero() && a.ValidTo.Before(b.ValidFrom)
+	}
+
+	return !endsBefore(r, other) && !endsBefore(other, r)
+}
+
+func (r TaxRate) String() string {
+	return fmt.Sprintf("%s %s %s (%g%%)", r.Jurisdiction, r.Type.Type(), r.Category, r.Rate)
+}
+
+// TaxRates is a registry of TaxRate entries.
+type TaxRates struct {
+	mu    sync.RWMutex
+	rates []TaxRate
+}
+
+// NewTaxRates creates a registry containing the given rates.
+func NewTaxRates(rates ...TaxRate) (*TaxRates, error) {
+	registry := &TaxRates{}
+	for _, rate := range rates {
+		if err := registry.Add(rate); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// Add registers the rate. Rates for the same jurisdiction, TaxType and TaxCategory must not overlap in time.
+func (r *TaxRates) Add(rate TaxRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rate.ValidFrom = truncateToDay(rate.ValidFrom)
+	if !rate.ValidTo.IsZero() {
+		rate.ValidTo = truncateToDay(rate.ValidTo)
+	}
+
+	for _, existing := range r.rates {
+		if existing.overlaps(rate) {
+			return fmt.Errorf("%w: %s and %s", ErrOverlappingTaxRate, existing, rate)
+		}
+	}
+
+	r.rates = append(r.rates, rate)
+	return nil
+}
+
+// Rates returns all registered rates.
+func (r *TaxRates) Rates() []TaxRate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]TaxRate(nil), r.rates...)
+}
+
+// Lookup returns the TaxRate effective at the given time.
+// If no rate is registered for a regional jurisdiction like "US-CA", the rate of its country ("US") is used.
+func (r *TaxRates) Lookup(jurisdiction string, taxType TaxType, category TaxCategory, at time.Time) (TaxRate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for current := jurisdiction; current != ""; {
+		for _, rate := range r.rates {
+			if rate.Jurisdiction == current && rate.Type == taxType && rate.Category == category && rate.EffectiveAt(at) {
+				return rate, nil
+			}
+		}
+
+		i := strings.LastIndex(current, "-")
+		if i < 0 {
+			break
+		}
+		current = current[:i]
+	}
+
+	return TaxRate{}, fmt.Errorf("%w: %s %s %s at %s", ErrTaxRateNotFound, jurisdiction, taxType.Type(), category, at.Format(time.DateOnly))
+}
+
+// Tax returns the Tax effective at the given time.
+func (r *TaxRates) Tax(jurisdiction string, taxType TaxType, category TaxCategory, at time.Time) (Tax, error) {
+	rate, err := r.Lookup(jurisdiction, taxType, category, at)
+	if err != nil {
+		return Tax{}, err
+	}
+
+	return rate.Tax(), nil
+}
+
+//go:embed tax_rates.json
+var defaultTaxRatesJSON []byte
+
+// DefaultTaxRates contains historical VAT rates of selected countries.
+var DefaultTaxRates *TaxRates
+
+// init loads DefaultTaxRates once all TaxTypes are registered.
+func init() {
+	DefaultTaxRates = mustLoadDefaultTaxRates()
+}
+
+func mustLoadDefaultTaxRates() *TaxRates {
+	rates, err := LoadTaxRatesJSON(bytes.NewReader(defaultTaxRatesJSON))
+	if err != nil {
+		panic(fmt.Sprintf("loading embedded tax rates: %v", err))
+	}
+
+	registry, err := NewTaxRates(rates...)
+	if err != nil {
+		panic(fmt.Sprintf("loading embedded tax rates: %v", err))
+	}
+
+	return registry
+}
+
+type taxRateRecord struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	Type         string  `json:"type"`
+	Category     string  `json:"category"`
+	Rate         float64 `json:"rate"`
+	ValidFrom    string  `json:"valid_from"`
+	ValidTo      string  `json:"valid_to,omitempty"`
+}
+
+// LoadTaxRatesJSON reads rates from a JSON array of objects with the fields
+// jurisdiction, type, category, rate, valid_from and optional valid_to. Dates use the YYYY-MM-DD format
+// and tax types are resolved by name from TaxTypes.
+func LoadTaxRatesJSON(r io.Reader) ([]TaxRate, error) {
+	var records []taxRateRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTaxRateTable, err)
+	}
+
+	rates := make([]TaxRate, len(records))
+	for i, record := range records {
+		rate, err := record.toTaxRate()
+		if err != nil {
+			return nil, fmt.Errorf("%w: entry %d: %w", ErrInvalidTaxRateTable, i, err)
+		}
+		rates[i] = rate
+	}
+
+	return rates, nil
+}
+
+// LoadTaxRatesCSV reads rates from CSV with the header
+// jurisdiction,type,category,rate,valid_from,valid_to. The valid_to column may be empty.
+func LoadTaxRatesCSV(r io.Reader) ([]TaxRate, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTaxRateTable, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"jurisdiction", "type", "category", "rate", "valid_from", "valid_to"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("%w: missing column %q", ErrInvalidTaxRateTable, name)
+		}
+	}
+
+	rates := make([]TaxRate, 0, len(rows)-1)
+	for line, row := range rows[1:] {
+		rate, err := strconv.ParseFloat(row[columns["rate"]], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidTaxRateTable, line+2, err)
+		}
+
+		record := taxRateRecord{
+			Jurisdiction: row[columns["jurisdiction"]],
+			Type:         row[columns["type"]],
+			Category:     row[columns["category"]],
+			Rate:         rate,
+			ValidFrom:    row[columns["valid_from"]],
+			ValidTo:      row[columns["valid_to"]],
+		}
+
+		taxRate, err := record.toTaxRate()
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrInvalidTaxRateTable, line+2, err)
+		}
+		rates = append(rates, taxRate)
+	}
+
+	return rates, nil
+}
+
+func (r taxRateRecord) toTaxRate() (TaxRate, error) {
+	taxType, ok := TaxTypes[r.Type]
+	if !ok {
+		return TaxRate{}, fmt.Errorf("%w %q", ErrUnknownTaxType, r.Type)
+	}
+
+	validFrom, err := time.Parse(time.DateOnly, r.ValidFrom)
+	if err != nil {
+		return TaxRate{}, fmt.Errorf("valid_from: %w", err)
+	}
+
+	var validTo time.Time
+	if r.ValidTo != "" {
+		validTo, err = time.Parse(time.DateOnly, r.ValidTo)
+		if err != nil {
+			return TaxRate{}, fmt.Errorf("valid_to: %w", err)
+		}
+	}
+
+	return TaxRate{
+		Jurisdiction: r.Jurisdiction,
+		Type:         taxType,
+		Category:     TaxCategory(r.Category),
+		Rate:         r.Rate,
+		ValidFrom:    validFrom,
+		ValidTo:      validTo,
+	}, nil
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,111 @@
+package money_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric/money"
+	isser "github.com/matryer/is"
+)
+
+func TestDefaultTaxRates(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		jurisdiction string
+		category     money.TaxCategory
+		at           time.Time
+		expected     float64
+	}{
+		{name: "PL_Standard_2010", jurisdiction: "PL", category: money.StandardRate, at: date(2010, time.December, 31), expected: 22},
+		{name: "PL_Standard_2011", jurisdiction: "PL", category: money.StandardRate, at: date(2011, time.January, 1), expected: 23},
+		{name: "PL_Reduced", jurisdiction: "PL", category: money.ReducedRate, at: date(2024, time.May, 1), expected: 8},
+		{name: "DE_Standard_Covid", jurisdiction: "DE", category: money.StandardRate, at: date(2020, time.September, 1), expected: 16},
+		{name: "DE_Standard_2021", jurisdiction: "DE", category: money.StandardRate, at: date(2021, time.January, 1), expected: 19},
+		{name: "GB_Exempt", jurisdiction: "GB", category: money.Exempt, at: date(2024, time.May, 1), expected: 0},
+		{name: "RegionalFallback", jurisdiction: "GB-SCT", category: money.StandardRate, at: date(2024, time.May, 1), expected: 20},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			tax, err := money.DefaultTaxRates.Tax(tt.jurisdiction, money.VAT, tt.category, tt.at)
+			is.NoErr(err)
+			is.Equal(tax.Rate(), tt.expected)
+			is.Equal(tax.Type(), money.VAT)
+		})
+	}
+}
+
+func TestTaxRates(t *testing.T) {
+	is := isser.New(t)
+
+	registry, err := money.NewTaxRates(money.TaxRate{
+		Jurisdiction: "US-CA",
+		Type:         money.VAT,
+		Category:     money.StandardRate,
+		Rate:         7.25,
+		ValidFrom:    time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	is.NoErr(err)
+
+	rate, err := registry.Lookup("US-CA", money.VAT, money.StandardRate, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+	is.NoErr(err)
+	is.Equal(rate.Rate, 7.25)
+
+	_, err = registry.Lookup("US-CA", money.VAT, money.StandardRate, time.Date(2016, time.May, 1, 0, 0, 0, 0, time.UTC))
+	is.True(errors.Is(err, money.ErrTaxRateNotFound))
+
+	_, err = registry.Lookup("US-NY", money.VAT, money.StandardRate, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC))
+	is.True(errors.Is(err, money.ErrTaxRateNotFound))
+
+	err = registry.Add(money.TaxRate{
+		Jurisdiction: "US-CA",
+		Type:         money.VAT,
+		Category:     money.StandardRate,
+		Rate:         7.5,
+		ValidFrom:    time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	is.True(errors.Is(err, money.ErrOverlappingTaxRate))
+}
+
+func TestLoadTaxRatesCSV(t *testing.T) {
+	is := isser.New(t)
+
+	rates, err := money.LoadTaxRatesCSV(strings.NewReader(`jurisdiction,type,category,rate,valid_from,valid_to
+CZ,VAT,standard,21,2013-01-01,
+CZ,VAT,reduced,15,2013-01-01,2023-12-31
+`))
+	is.NoErr(err)
+	is.Equal(len(rates), 2)
+	is.Equal(rates[0].Jurisdiction, "CZ")
+	is.Equal(rates[0].Rate, 21.0)
+	is.True(rates[0].ValidTo.IsZero())
+	is.Equal(rates[1].Category, money.ReducedRate)
+	is.True(rates[1].EffectiveAt(time.Date(2023, time.December, 31, 23, 0, 0, 0, time.UTC)))
+	is.True(!rates[1].EffectiveAt(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, err = money.LoadTaxRatesCSV(strings.NewReader("jurisdiction,type,category,rate,valid_from,valid_to\nCZ,GST,standard,21,2013-01-01,\n"))
+	is.True(errors.Is(err, money.ErrUnknownTaxType))
+}
+
+func TestLoadTaxRatesJSON(t *testing.T) {
+	is := isser.New(t)
+
+	rates, err := money.LoadTaxRatesJSON(strings.NewReader(`[
+		{"jurisdiction": "FR", "type": "VAT", "category": "standard", "rate": 20, "valid_from": "2014-01-01"}
+	]`))
+	is.NoErr(err)
+	is.Equal(len(rates), 1)
+	is.Equal(rates[0].Tax().String(), "VAT (20%)")
+
+	_, err = money.LoadTaxRatesJSON(strings.NewReader(`[{"jurisdiction": "FR", "type": "VAT", "valid_from": "01/01/2014"}]`))
+	is.True(errors.Is(err, money.ErrInvalidTaxRateTable))
+}
@@ -3,6 +3,7 @@ package money_test
 import (
 	"testing"
 
+	"github.com/IAmRadek/metric"
 	"github.com/IAmRadek/metric/money"
 	isser "github.com/matryer/is"
 )
@@ -39,6 +40,22 @@ func TestTax(t *testing.T) {
 	}
 }
 
+func TestNewTaxFromRate(t *testing.T) {
+	is := isser.New(t)
+
+	tax, err := money.NewTaxFromRate(metric.NewQuantity(0.23, metric.One), money.VAT)
+	is.NoErr(err)
+	is.Equal(tax.Rate(), 23.0)
+	is.Equal(tax.Percentage().Metric(), metric.Percent)
+
+	eq, err := tax.Calculate(money.NewMoney(1000, money.USD)).Equals(money.NewMoney(230, money.USD))
+	is.NoErr(err)
+	is.True(eq)
+
+	_, err = money.NewTaxFromRate(metric.NewQuantity(23, metric.Kilogram), money.VAT)
+	is.Equal(err, metric.ErrIncompatibleMetric{M1: metric.Kilogram, M2: metric.Percent})
+}
+
 func TestTaxType(t *testing.T) {
 	tests := []struct {
 		name    string
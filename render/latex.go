@@ -0,0 +1,205 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric"
+)
+
+// thinSpace separates the amount from the unit and the factors of a unit in LaTeX math mode.
+const thinSpace = `\,`
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\backslash `,
+	"{", `\{`,
+	"}", `\}`,
+	"%", `\%`,
+	"_", `\_`,
+	"&", `\&`,
+	"#", `\#`,
+	"$", `\$`,
+	"^", `\hat{}`,
+	"~", `\sim `,
+	" ", `\ `,
+	"µ", `\mu `,
+	"μ", `\mu `,
+	"Ω", `\Omega `,
+	"°", `{}^{\circ}`,
+	"′", `{}^{\prime}`,
+	"″", `{}^{\prime\prime}`,
+	"‰", `\text{\textperthousand}`,
+)
+
+// LaTeX renders the Quantity in LaTeX math mode, without the surrounding delimiters,
+// e.g. `9.81\,\frac{\mathrm{m}}{\mathrm{s}^{2}}` or `9.81\,\mathrm{m}\,\mathrm{s}^{-2}`.
+// Amounts with an exponent are written as powers of ten, e.g. `1.5\times10^{21}`.
+func LaTeX(quantity metric.Quantity, style Style) string {
+	mantissa, exponent := number(quantity.Amount())
+	amount := mantissa
+	if exponent != "" {
+		amount += `\times10^{` + exponent + "}"
+	}
+
+	unit := LaTeXUnit(quantity.Metric(), style)
+	if unit == "" {
+		return amount
+	}
+	return amount + thinSpace + unit
+}
+
+// LaTeXUnit renders the unit in LaTeX math mode, with upright symbols separated by thin spaces,
+// e.g. `\mathrm{kg}\,\mathrm{m}^{2}`. The unit one renders as an empty string.
+func LaTeXUnit(unit metric.Metric, style Style) string {
+	factors := factorsOf(unit)
+
+	if style == NegativeExponents {
+		return latexFactors(factors)
+	}
+
+	numerator, denominator := split(factors)
+	if len(denominator) == 0 {
+		return latexFactors(numerator)
+	}
+	if len(numerator) == 0 {
+		return `\frac{1}{` + latexFactors(denominator) + "}"
+	}
+	return `\frac{` + latexFactors(numerator) + "}{" + latexFactors(denominator) + "}"
+}
+
+func latexFactors(factors []factor) string {
+	rendered := make([]string, len(factors))
+	for i, f := range factors {
+		rendered[i] = `\mathrm{` + latexEscaper.Replace(f.symbol) + "}"
+		if f.exponent != 1 {
+			rendered[i] += "^{" + strconv.Itoa(f.exponent) + "}"
+		}
+	}
+	return strings.Join(rendered, thinSpace)
+}
+
+// siunitxMacros lists the siunitx macros of units; prefixed units combine the macros of the prefix and the unit.
+var siunitxMacros = map[metric.Metric]string{
+	metric.Meter:     `\metre`,
+	metric.Kilogram:  `\kilogram`,
+	metric.Second:    `\second`,
+	metric.Ampere:    `\ampere`,
+	metric.Kelvin:    `\kelvin`,
+	metric.Celsius:   `\degreeCelsius`,
+	metric.Mole:      `\mole`,
+	metric.Candela:   `\candela`,
+	metric.Radian:    `\radian`,
+	metric.Steradian: `\steradian`,
+	metric.Joule:     `\joule`,
+	metric.Watt:      `\watt`,
+	metric.Volt:      `\volt`,
+	metric.Lumen:     `\lumen`,
+	metric.Lux:       `\lux`,
+	metric.Minute:    `\minute`,
+	metric.Hour:      `\hour`,
+	metric.Day:       `\day`,
+	metric.Degree:    `\degree`,
+	metric.Arcminute: `\arcminute`,
+	metric.Arcsecond: `\arcsecond`,
+	metric.Percent:   `\percent`,
+	metric.Bel:       `\bel`,
+	metric.Decibel:   `\decibel`,
+	metric.Neper:     `\neper`,
+}
+
+// Siunitx renders the Quantity with the \SI command of the LaTeX siunitx package,
+// e.g. `\SI{9.81}{\metre\per\second\squared}`. The Fraction style sets the per-mode option of siunitx,
+// e.g. `\SI[per-mode=fraction]{9.81}{\metre\per\second\squared}`.
+func Siunitx(quantity metric.Quantity, style Style) string {
+	return `\SI` + siunitxOptions(style) + "{" + strconv.FormatFloat(quantity.Amount(), 'g', -1, 64) + "}{" + siunitxUnit(quantity.Metric()) + "}"
+}
+
+// SiunitxUnit renders the unit with the \si command of the LaTeX siunitx package, e.g. `\si{\kilo\metre\per\hour}`.
+// If any of its factors has no siunitx macro, the whole unit is written in the literal form, e.g. `\si{kbit/s}`.
+func SiunitxUnit(unit metric.Metric, style Style) string {
+	return `\si` + siunitxOptions(style) + "{" + siunitxUnit(unit) + "}"
+}
+
+func siunitxOptions(style Style) string {
+	if style == Fraction {
+		return "[per-mode=fraction]"
+	}
+	return ""
+}
+
+func siunitxUnit(unit metric.Metric) string {
+	factors := factorsOf(unit)
+
+	macros := make([]string, len(factors))
+	for i, f := range factors {
+		macro, ok := siunitxMacro(f.unit)
+		if !ok {
+			return siunitxLiteral(factors)
+		}
+		macros[i] = siunitxPower(macro, f.exponent)
+	}
+
+	return strings.Join(macros, "")
+}
+
+// siunitxMacro returns the macro of the unit, e.g. `\milli\second` for the millisecond.
+func siunitxMacro(unit metric.Metric) (string, bool) {
+	if macro, ok := siunitxMacros[unit]; ok {
+		return macro, true
+	}
+
+	u, ok := unit.(metric.Unit)
+	if !ok {
+		return "", false
+	}
+	base, prefix, ok := metric.Unprefixed(u)
+	if !ok {
+		return "", false
+	}
+	macro, ok := siunitxMacros[base]
+	if !ok {
+		return "", false
+	}
+
+	return `\` + prefix.Name + macro, true
+}
+
+func siunitxPower(macro string, exponent int) string {
+	if exponent < 0 {
+		return `\per` + siunitxPower(macro, -exponent)
+	}
+
+	switch exponent {
+	case 1:
+		return macro
+	case 2:
+		return macro + `\squared`
+	case 3:
+		return macro + `\cubed`
+	default:
+		return macro + `\tothe{` + strconv.Itoa(exponent) + "}"
+	}
+}
+
+// siunitxLiteral writes the factors in the literal form of siunitx, with "." for products and "/" before divisors.
+func siunitxLiteral(factors []factor) string {
+	var b strings.Builder
+
+	for _, f := range factors {
+		exponent := f.exponent
+		switch {
+		case exponent < 0:
+			b.WriteByte('/')
+			exponent = -exponent
+		case b.Len() > 0:
+			b.WriteByte('.')
+		}
+
+		b.WriteString(latexEscaper.Replace(f.symbol))
+		if exponent != 1 {
+			b.WriteString("^{" + strconv.Itoa(exponent) + "}")
+		}
+	}
+
+	return b.String()
+}
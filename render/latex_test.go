@@ -0,0 +1,179 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/render"
+	isser "github.com/matryer/is"
+)
+
+func mustMultiplyBy(q1, q2 metric.Quantity) metric.Quantity {
+	product, err := q1.MultiplyBy(q2)
+	if err != nil {
+		panic(err)
+	}
+	return product
+}
+
+func mustDivideBy(q1, q2 metric.Quantity) metric.Quantity {
+	quotient, err := q1.DivideBy(q2)
+	if err != nil {
+		panic(err)
+	}
+	return quotient
+}
+
+var (
+	newtonMeter = metric.NewDerivedUnit("newton meter", "", "", nil,
+		metric.NewDerivedUnitTerm(metric.Kilogram, 1),
+		metric.NewDerivedUnitTerm(metric.Meter, 2),
+		metric.NewDerivedUnitTerm(metric.Second, -2),
+	)
+	reciprocalSecond = metric.NewDerivedUnit("reciprocal second", "", "", nil, metric.NewDerivedUnitTerm(metric.Second, -1))
+)
+
+func TestLaTeXUnit(t *testing.T) {
+	tests := []struct {
+		name      string
+		unit      metric.Metric
+		fraction  string
+		exponents string
+	}{
+		{name: "Meter", unit: metric.Meter, fraction: `\mathrm{m}`, exponents: `\mathrm{m}`},
+		{name: "Area", unit: metric.Area, fraction: `\mathrm{m}^{2}`, exponents: `\mathrm{m}^{2}`},
+		{name: "Speed", unit: metric.Speed, fraction: `\frac{\mathrm{m}}{\mathrm{s}}`, exponents: `\mathrm{m}\,\mathrm{s}^{-1}`},
+		{name: "Terms", unit: newtonMeter, fraction: `\frac{\mathrm{kg}\,\mathrm{m}^{2}}{\mathrm{s}^{2}}`, exponents: `\mathrm{kg}\,\mathrm{m}^{2}\,\mathrm{s}^{-2}`},
+		{name: "Reciprocal", unit: reciprocalSecond, fraction: `\frac{1}{\mathrm{s}}`, exponents: `\mathrm{s}^{-1}`},
+		{name: "Term", unit: metric.NewDerivedUnitTerm(metric.Meter, 2), fraction: `\mathrm{m}^{2}`, exponents: `\mathrm{m}^{2}`},
+		{name: "BitRate", unit: metric.KilobitPerSecond, fraction: `\frac{\mathrm{kbit}}{\mathrm{s}}`, exponents: `\mathrm{kbit}\,\mathrm{s}^{-1}`},
+		{name: "Microsecond", unit: metric.Microsecond, fraction: `\mathrm{\mu s}`, exponents: `\mathrm{\mu s}`},
+		{name: "Celsius", unit: metric.Celsius, fraction: `\mathrm{{}^{\circ}C}`, exponents: `\mathrm{{}^{\circ}C}`},
+		{name: "Percent", unit: metric.Percent, fraction: `\mathrm{\%}`, exponents: `\mathrm{\%}`},
+		{name: "One", unit: metric.One, fraction: ``, exponents: ``},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			is.Equal(render.LaTeXUnit(tt.unit, render.Fraction), tt.fraction)
+			is.Equal(render.LaTeXUnit(tt.unit, render.NegativeExponents), tt.exponents)
+		})
+	}
+}
+
+func TestLaTeX(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		style    render.Style
+		expected string
+	}{
+		{
+			name:     "Simple",
+			quantity: metric.NewQuantity(12.5, metric.Meter),
+			style:    render.Fraction,
+			expected: `12.5\,\mathrm{m}`,
+		},
+		{
+			name:     "Exponent",
+			quantity: metric.NewQuantity(1.5e21, metric.Meter),
+			style:    render.Fraction,
+			expected: `1.5\times10^{21}\,\mathrm{m}`,
+		},
+		{
+			name:     "NegativeExponent",
+			quantity: metric.NewQuantity(-2e-7, metric.Second),
+			style:    render.Fraction,
+			expected: `-2\times10^{-7}\,\mathrm{s}`,
+		},
+		{
+			name:     "MultiplyBy",
+			quantity: mustMultiplyBy(metric.NewQuantity(2, metric.Meter), metric.NewQuantity(3, metric.Second)),
+			style:    render.NegativeExponents,
+			expected: `6\,\mathrm{m}\,\mathrm{s}`,
+		},
+		{
+			name:     "DivideByCombinesFactors",
+			quantity: mustDivideBy(metric.NewQuantity(10, metric.Speed), metric.NewQuantity(2, metric.Second)),
+			style:    render.NegativeExponents,
+			expected: `5\,\mathrm{m}\,\mathrm{s}^{-2}`,
+		},
+		{
+			name:     "CancelledFactors",
+			quantity: mustMultiplyBy(metric.NewQuantity(10, metric.Speed), metric.NewQuantity(2, metric.Second)),
+			style:    render.Fraction,
+			expected: `20\,\mathrm{m}`,
+		},
+		{
+			name:     "Dimensionless",
+			quantity: metric.NewQuantity(0.5, metric.One),
+			style:    render.Fraction,
+			expected: `0.5`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(render.LaTeX(tt.quantity, tt.style), tt.expected)
+		})
+	}
+}
+
+func TestSiunitx(t *testing.T) {
+	tests := []struct {
+		name     string
+		render   func() string
+		expected string
+	}{
+		{
+			name:     "Quantity",
+			render:   func() string { return render.Siunitx(metric.NewQuantity(9.81, metric.Speed), render.NegativeExponents) },
+			expected: `\SI{9.81}{\metre\per\second}`,
+		},
+		{
+			name:     "Fraction",
+			render:   func() string { return render.Siunitx(metric.NewQuantity(9.81, metric.Speed), render.Fraction) },
+			expected: `\SI[per-mode=fraction]{9.81}{\metre\per\second}`,
+		},
+		{
+			name:     "Powers",
+			render:   func() string { return render.SiunitxUnit(newtonMeter, render.NegativeExponents) },
+			expected: `\si{\kilogram\metre\squared\per\second\squared}`,
+		},
+		{
+			name:     "Prefixed",
+			render:   func() string { return render.SiunitxUnit(metric.Millisecond, render.NegativeExponents) },
+			expected: `\si{\milli\second}`,
+		},
+		{
+			name:     "Celsius",
+			render:   func() string { return render.SiunitxUnit(metric.Celsius, render.NegativeExponents) },
+			expected: `\si{\degreeCelsius}`,
+		},
+		{
+			name:     "Literal",
+			render:   func() string { return render.SiunitxUnit(metric.KilobitPerSecond, render.Fraction) },
+			expected: `\si[per-mode=fraction]{kbit/s}`,
+		},
+		{
+			name: "LiteralPowers",
+			render: func() string {
+				return render.SiunitxUnit(mustMultiplyBy(metric.NewQuantity(1, metric.Byte), metric.NewQuantity(1, metric.Area)).Metric(), render.NegativeExponents)
+			},
+			expected: `\si{B.m^{2}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(tt.render(), tt.expected)
+		})
+	}
+}
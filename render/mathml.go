@@ -0,0 +1,86 @@
+package render
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric"
+)
+
+const (
+	mathmlNamespace = "http://www.w3.org/1998/Math/MathML"
+
+	// mathmlThinSpace separates the amount from the unit and the factors of a unit.
+	mathmlThinSpace = `<mspace width="0.1667em"/>`
+)
+
+// MathML renders the Quantity as a MathML math element, e.g. for 9.81 m/s² in the Fraction style
+// `<math xmlns="http://www.w3.org/1998/Math/MathML"><mn>9.81</mn><mspace width="0.1667em"/><mfrac>...</mfrac></math>`.
+// Amounts with an exponent are written as powers of ten.
+func MathML(quantity metric.Quantity, style Style) string {
+	content := mathmlNumber(quantity.Amount())
+	if unit := mathmlUnit(quantity.Metric(), style); unit != "" {
+		content += mathmlThinSpace + unit
+	}
+
+	return mathmlElement(content)
+}
+
+// MathMLUnit renders the unit as a MathML math element, with upright identifiers separated by thin spaces.
+func MathMLUnit(unit metric.Metric, style Style) string {
+	return mathmlElement(mathmlUnit(unit, style))
+}
+
+func mathmlElement(content string) string {
+	return `<math xmlns="` + mathmlNamespace + `">` + content + "</math>"
+}
+
+func mathmlUnit(unit metric.Metric, style Style) string {
+	factors := factorsOf(unit)
+
+	if style == NegativeExponents {
+		return mathmlFactors(factors)
+	}
+
+	numerator, denominator := split(factors)
+	if len(denominator) == 0 {
+		return mathmlFactors(numerator)
+	}
+
+	top := "<mn>1</mn>"
+	if len(numerator) > 0 {
+		top = "<mrow>" + mathmlFactors(numerator) + "</mrow>"
+	}
+	return "<mfrac>" + top + "<mrow>" + mathmlFactors(denominator) + "</mrow></mfrac>"
+}
+
+func mathmlFactors(factors []factor) string {
+	rendered := make([]string, len(factors))
+	for i, f := range factors {
+		identifier := `<mi mathvariant="normal">` + html.EscapeString(f.symbol) + "</mi>"
+		if f.exponent != 1 {
+			identifier = "<msup>" + identifier + mathmlSigned(strconv.Itoa(f.exponent)) + "</msup>"
+		}
+		rendered[i] = identifier
+	}
+	return strings.Join(rendered, mathmlThinSpace)
+}
+
+func mathmlNumber(amount float64) string {
+	mantissa, exponent := number(amount)
+
+	rendered := mathmlSigned(mantissa)
+	if exponent != "" {
+		rendered += "<mo>×</mo><msup><mn>10</mn>" + mathmlSigned(exponent) + "</msup>"
+	}
+	return rendered
+}
+
+// mathmlSigned renders the number with a minus sign operator if it is negative, e.g. `<mrow><mo>−</mo><mn>1</mn></mrow>`.
+func mathmlSigned(number string) string {
+	if unsigned, ok := strings.CutPrefix(number, "-"); ok {
+		return "<mrow><mo>−</mo><mn>" + unsigned + "</mn></mrow>"
+	}
+	return "<mn>" + number + "</mn>"
+}
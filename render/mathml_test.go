@@ -0,0 +1,83 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/render"
+	isser "github.com/matryer/is"
+)
+
+func TestMathMLUnit(t *testing.T) {
+	const (
+		opening = `<math xmlns="http://www.w3.org/1998/Math/MathML">`
+		closing = `</math>`
+		space   = `<mspace width="0.1667em"/>`
+	)
+
+	tests := []struct {
+		name     string
+		unit     metric.Metric
+		style    render.Style
+		expected string
+	}{
+		{
+			name:     "Meter",
+			unit:     metric.Meter,
+			style:    render.Fraction,
+			expected: opening + `<mi mathvariant="normal">m</mi>` + closing,
+		},
+		{
+			name:     "Area",
+			unit:     metric.Area,
+			style:    render.Fraction,
+			expected: opening + `<msup><mi mathvariant="normal">m</mi><mn>2</mn></msup>` + closing,
+		},
+		{
+			name:     "SpeedFraction",
+			unit:     metric.Speed,
+			style:    render.Fraction,
+			expected: opening + `<mfrac><mrow><mi mathvariant="normal">m</mi></mrow><mrow><mi mathvariant="normal">s</mi></mrow></mfrac>` + closing,
+		},
+		{
+			name:  "SpeedNegativeExponents",
+			unit:  metric.Speed,
+			style: render.NegativeExponents,
+			expected: opening + `<mi mathvariant="normal">m</mi>` + space +
+				`<msup><mi mathvariant="normal">s</mi><mrow><mo>−</mo><mn>1</mn></mrow></msup>` + closing,
+		},
+		{
+			name:     "Reciprocal",
+			unit:     reciprocalSecond,
+			style:    render.Fraction,
+			expected: opening + `<mfrac><mn>1</mn><mrow><mi mathvariant="normal">s</mi></mrow></mfrac>` + closing,
+		},
+		{
+			name:     "Escaped",
+			unit:     metric.NewBaseUnit("less", "", "<x>", nil),
+			style:    render.Fraction,
+			expected: opening + `<mi mathvariant="normal">&lt;x&gt;</mi>` + closing,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+			is.Equal(render.MathMLUnit(tt.unit, tt.style), tt.expected)
+		})
+	}
+}
+
+func TestMathML(t *testing.T) {
+	is := isser.New(t)
+
+	is.Equal(
+		render.MathML(metric.NewQuantity(12.5, metric.Meter), render.Fraction),
+		`<math xmlns="http://www.w3.org/1998/Math/MathML"><mn>12.5</mn><mspace width="0.1667em"/><mi mathvariant="normal">m</mi></math>`,
+	)
+	is.Equal(
+		render.MathML(metric.NewQuantity(-1.5e-21, metric.One), render.Fraction),
+		`<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mo>−</mo><mn>1.5</mn></mrow><mo>×</mo><msup><mn>10</mn><mrow><mo>−</mo><mn>21</mn></mrow></msup></math>`,
+	)
+}
@@ -0,0 +1,193 @@
+// Package render renders units and quantities for typesetting, as LaTeX math, as LaTeX using the siunitx package,
+// and as MathML.
+//
+// Composite units, such as those of metric.Speed or of the results of Quantity.MultiplyBy and Quantity.DivideBy,
+// are rendered factor by factor with proper superscripts and thin spaces between the factors.
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric"
+)
+
+// Style selects how units with negative exponents are rendered.
+type Style int
+
+const (
+	// Fraction puts units with negative exponents into a denominator, e.g. m/s.
+	Fraction Style = iota
+	// NegativeExponents keeps all units on one line, raising the divisors to negative powers, e.g. m s⁻¹.
+	NegativeExponents
+)
+
+// factor is a unit raised to an exponent. The unit is nil for factors read from the symbol of a composite unit.
+type factor struct {
+	unit     metric.Metric
+	symbol   string
+	exponent int
+}
+
+// factorsOf expands the unit into factors that are neither products nor quotients, merging repeated units.
+func factorsOf(unit metric.Metric) []factor {
+	return combine(expand(unit, 1))
+}
+
+func expand(unit metric.Metric, exponent int) []factor {
+	if term, ok := unit.(metric.DerivedUnitTerm); ok {
+		return expand(term.Metric(), term.Exponent()*exponent)
+	}
+
+	if unit == metric.One {
+		return nil
+	}
+
+	symbol := unit.Symbol()
+	if !isComposite(symbol) {
+		return []factor{{unit, symbol, exponent}}
+	}
+
+	// The terms are used only if they describe the symbol; e.g. the terms of the kilobit per second are those of
+	// the bit per second, so its symbol is read instead.
+	if derived, ok := unit.(metric.DerivedUnit); ok && len(derived.Terms()) > 0 {
+		if symbol == "" || normalize(symbol) == normalize(metric.SymbolOf(derived.Terms()...)) {
+			var factors []factor
+			for _, term := range derived.Terms() {
+				factors = append(factors, expand(term.Metric(), term.Exponent()*exponent)...)
+			}
+			return factors
+		}
+	}
+
+	factors := parseSymbol(symbol)
+	for i := range factors {
+		factors[i].exponent *= exponent
+	}
+	return factors
+}
+
+// isComposite returns true if the symbol is empty or a product, quotient or power of units, e.g. "m/s" or "m²".
+func isComposite(symbol string) bool {
+	if symbol == "" || strings.ContainsAny(symbol, "/*·^") {
+		return true
+	}
+	return strings.ContainsFunc(symbol, isSuperscript)
+}
+
+var digits = strings.NewReplacer(
+	"⁻", "-", "⁰", "0", "¹", "1", "²", "2", "³", "3", "⁴", "4", "⁵", "5", "⁶", "6", "⁷", "7", "⁸", "8", "⁹", "9",
+)
+
+func isSuperscript(r rune) bool {
+	return strings.ContainsRune("⁻⁰¹²³⁴⁵⁶⁷⁸⁹", r)
+}
+
+// normalize writes products with "*" and exponents in superscript, e.g. "m²·s^-1" becomes "m²*s⁻¹".
+func normalize(symbol string) string {
+	symbol = strings.ReplaceAll(symbol, "·", "*")
+
+	var b strings.Builder
+	for {
+		before, after, ok := strings.Cut(symbol, "^")
+		b.WriteString(before)
+		if !ok {
+			return b.String()
+		}
+
+		end := 0
+		for end < len(after) && (after[end] == '-' || after[end] >= '0' && after[end] <= '9') {
+			end++
+		}
+		if exponent, err := strconv.Atoi(after[:end]); err == nil {
+			b.WriteString(metric.Superscript(exponent))
+		} else {
+			b.WriteString(after[:end])
+		}
+		symbol = after[end:]
+	}
+}
+
+// parseSymbol reads the factors of a composite symbol such as "kbit/s" or "kg*m/s²".
+// Every factor after a "/" is a divisor, as in "J/kg*K".
+func parseSymbol(symbol string) []factor {
+	var factors []factor
+
+	for i, part := range strings.Split(normalize(symbol), "/") {
+		sign := 1
+		if i > 0 {
+			sign = -1
+		}
+
+		for _, s := range strings.Split(part, "*") {
+			base := strings.TrimRightFunc(s, isSuperscript)
+			if base == "" || base == "1" {
+				continue
+			}
+
+			exponent := 1
+			if power := s[len(base):]; power != "" {
+				exponent, _ = strconv.Atoi(digits.Replace(power))
+			}
+			factors = append(factors, factor{nil, base, sign * exponent})
+		}
+	}
+
+	return factors
+}
+
+// combine merges the exponents of repeated units in the order of their first appearance, dropping those that cancel out.
+func combine(factors []factor) []factor {
+	var combined []factor
+	index := make(map[any]int)
+
+	for _, f := range factors {
+		var key any = f.symbol
+		if f.unit != nil {
+			key = f.unit
+		}
+		if i, ok := index[key]; ok {
+			combined[i].exponent += f.exponent
+			continue
+		}
+		index[key] = len(combined)
+		combined = append(combined, f)
+	}
+
+	result := combined[:0]
+	for _, f := range combined {
+		if f.exponent != 0 {
+			result = append(result, f)
+		}
+	}
+
+	return result
+}
+
+// split separates the factors with positive exponents from the divisors, whose exponents are made positive.
+func split(factors []factor) (numerator, denominator []factor) {
+	for _, f := range factors {
+		if f.exponent < 0 {
+			denominator = append(denominator, factor{f.unit, f.symbol, -f.exponent})
+		} else {
+			numerator = append(numerator, f)
+		}
+	}
+	return numerator, denominator
+}
+
+// number splits the shortest representation of the amount into its mantissa and decimal exponent, e.g. "1.5" and "21" for 1.5e21.
+// The exponent is empty if the amount is written without one.
+func number(amount float64) (mantissa, exponent string) {
+	mantissa, exponent, _ = strings.Cut(strconv.FormatFloat(amount, 'g', -1, 64), "e")
+	exponent = strings.TrimPrefix(exponent, "+")
+	sign := ""
+	if unsigned, ok := strings.CutPrefix(exponent, "-"); ok {
+		sign, exponent = "-", unsigned
+	}
+
+	if exponent == "" {
+		return mantissa, ""
+	}
+	return mantissa, sign + strings.TrimLeft(exponent, "0")
+}
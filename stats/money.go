@@ -0,0 +1,220 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/money"
+	"github.com/govalues/decimal"
+)
+
+// sameCurrency checks that there are amounts, all in the same Currency.
+func sameCurrency(amounts []money.Money) error {
+	if len(amounts) == 0 {
+		return ErrEmpty
+	}
+
+	for _, m := range amounts[1:] {
+		if m.Currency() != amounts[0].Currency() {
+			return metric.ErrIncompatibleMetric{M1: amounts[0].Metric(), M2: m.Metric()}
+		}
+	}
+	return nil
+}
+
+// SumMoney returns the exact sum of the amounts, which must be in the same Currency.
+func SumMoney(amounts []money.Money) (money.Money, error) {
+	if err := sameCurrency(amounts); err != nil {
+		return money.Money{}, err
+	}
+
+	total := amounts[0]
+	for _, m := range amounts[1:] {
+		var err error
+		if total, err = total.Add(m); err != nil {
+			return money.Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// MeanMoney returns the mean of the amounts, which must be in the same Currency, rounded to the Currency.
+func MeanMoney(amounts []money.Money) (money.Money, error) {
+	mean, err := meanDecimal(amounts)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.NewMoneyFromDecimal(mean, amounts[0].Currency()), nil
+}
+
+// WeightedMeanMoney returns the mean of the amounts weighted by the non-negative weights, rounded to the Currency.
+func WeightedMeanMoney(amounts []money.Money, weights []float64) (money.Money, error) {
+	if len(weights) != len(amounts) {
+		return money.Money{}, fmt.Errorf("%w: %d weights for %d values", ErrInvalidWeights, len(weights), len(amounts))
+	}
+	if err := sameCurrency(amounts); err != nil {
+		return money.Money{}, err
+	}
+
+	total, weightTotal := decimal.Zero, decimal.Zero
+	for i, w := range weights {
+		weight, err := decimal.NewFromFloat64(w)
+		if err != nil || weight.IsNeg() {
+			return money.Money{}, fmt.Errorf("%w: negative weight %v", ErrInvalidWeights, w)
+		}
+		if total, err = total.AddMul(amounts[i].Decimal(), weight); err != nil {
+			return money.Money{}, err
+		}
+		if weightTotal, err = weightTotal.Add(weight); err != nil {
+			return money.Money{}, err
+		}
+	}
+	if weightTotal.IsZero() {
+		return money.Money{}, fmt.Errorf("%w: the weights add up to zero", ErrInvalidWeights)
+	}
+
+	mean, err := total.Quo(weightTotal)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.NewMoneyFromDecimal(mean, amounts[0].Currency()), nil
+}
+
+// MedianMoney returns the median of the amounts, which must be in the same Currency.
+// The median of an even number of amounts is the mean of the middle two, rounded to the Currency.
+func MedianMoney(amounts []money.Money) (money.Money, error) {
+	return PercentileMoney(amounts, 50)
+}
+
+// PercentileMoney returns the p-th percentile of the amounts, for p between 0 and 100, rounded to the Currency.
+// Like Percentile, it interpolates linearly between the closest ranks.
+func PercentileMoney(amounts []money.Money, p float64) (money.Money, error) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return money.Money{}, fmt.Errorf("%w: %v", ErrInvalidPercentile, p)
+	}
+
+	sorted, err := sortMoney(amounts)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	rank := p / 100 * float64(len(sorted)-1)
+	below := int(math.Floor(rank))
+	if below == len(sorted)-1 {
+		return sorted[below], nil
+	}
+
+	fraction, err := decimal.NewFromFloat64(rank - float64(below))
+	if err != nil {
+		return money.Money{}, err
+	}
+	spread, err := sorted[below+1].Decimal().Sub(sorted[below].Decimal())
+	if err != nil {
+		return money.Money{}, err
+	}
+	value, err := sorted[below].Decimal().AddMul(spread, fraction)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.NewMoneyFromDecimal(value, sorted[below].Currency()), nil
+}
+
+// VarianceMoney returns the population variance of the amounts in the square of the Currency, e.g. USD².
+// The variance is not Money, so it is returned as a metric.Quantity like Variance does.
+func VarianceMoney(amounts []money.Money) (metric.Quantity, error) {
+	v, err := varianceDecimal(amounts, 0)
+	if err != nil {
+		return nil, err
+	}
+	f, _ := v.Float64()
+	return metric.NewQuantity(f, squared(amounts[0].Currency())), nil
+}
+
+// StdDevMoney returns the population standard deviation of the amounts, rounded to the Currency.
+func StdDevMoney(amounts []money.Money) (money.Money, error) {
+	return stdDevMoney(amounts, 0)
+}
+
+// SampleStdDevMoney returns the sample standard deviation of the amounts, with Bessel's correction,
+// rounded to the Currency.
+func SampleStdDevMoney(amounts []money.Money) (money.Money, error) {
+	if len(amounts) == 1 {
+		return money.Money{}, fmt.Errorf("%w: the sample standard deviation requires at least 2 values", ErrEmpty)
+	}
+	return stdDevMoney(amounts, 1)
+}
+
+func stdDevMoney(amounts []money.Money, correction int) (money.Money, error) {
+	v, err := varianceDecimal(amounts, correction)
+	if err != nil {
+		return money.Money{}, err
+	}
+	deviation, err := v.Sqrt()
+	if err != nil {
+		return money.Money{}, err
+	}
+	return money.NewMoneyFromDecimal(deviation, amounts[0].Currency()), nil
+}
+
+// meanDecimal returns the unrounded mean of the amounts.
+func meanDecimal(amounts []money.Money) (decimal.Decimal, error) {
+	total, err := SumMoney(amounts)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return total.Decimal().Quo(decimal.MustNew(int64(len(amounts)), 0))
+}
+
+// varianceDecimal returns the unrounded variance of the amounts, dividing by their count less the correction.
+func varianceDecimal(amounts []money.Money, correction int) (decimal.Decimal, error) {
+	mean, err := meanDecimal(amounts)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	squares := decimal.Zero
+	for _, m := range amounts {
+		deviation, err := m.Decimal().Sub(mean)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		if squares, err = squares.AddMul(deviation, deviation); err != nil {
+			return decimal.Decimal{}, err
+		}
+	}
+
+	return squares.Quo(decimal.MustNew(int64(len(amounts)-correction), 0))
+}
+
+// MinMoney returns the smallest of the amounts, which must be in the same Currency.
+func MinMoney(amounts []money.Money) (money.Money, error) {
+	sorted, err := sortMoney(amounts)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return sorted[0], nil
+}
+
+// MaxMoney returns the largest of the amounts, which must be in the same Currency.
+func MaxMoney(amounts []money.Money) (money.Money, error) {
+	sorted, err := sortMoney(amounts)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return sorted[len(sorted)-1], nil
+}
+
+// sortMoney returns a sorted copy of the amounts.
+func sortMoney(amounts []money.Money) ([]money.Money, error) {
+	if err := sameCurrency(amounts); err != nil {
+		return nil, err
+	}
+
+	sorted := append([]money.Money(nil), amounts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Decimal().Less(sorted[j].Decimal())
+	})
+	return sorted, nil
+}
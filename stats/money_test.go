@@ -0,0 +1,109 @@
+package stats_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/money"
+	"github.com/IAmRadek/metric/stats"
+	"github.com/govalues/decimal"
+	isser "github.com/matryer/is"
+)
+
+func usd(amounts ...string) []money.Money {
+	result := make([]money.Money, len(amounts))
+	for i, amount := range amounts {
+		result[i] = money.NewMoneyFromDecimal(decimal.MustParse(amount), money.USD)
+	}
+	return result
+}
+
+func TestMoneyAggregates(t *testing.T) {
+	tests := []struct {
+		name      string
+		aggregate func([]money.Money) (money.Money, error)
+		amounts   []money.Money
+		expected  string
+	}{
+		{name: "Sum", aggregate: stats.SumMoney, amounts: usd("0.10", "0.20", "0.30"), expected: "0.60"},
+		{name: "Mean", aggregate: stats.MeanMoney, amounts: usd("10.00", "20.00", "33.00"), expected: "21.00"},
+		{name: "MedianOdd", aggregate: stats.MedianMoney, amounts: usd("5.00", "1.00", "3.00"), expected: "3.00"},
+		{name: "MeanRounded", aggregate: stats.MeanMoney, amounts: usd("10.00", "20.00", "20.01"), expected: "16.67"},
+		{name: "MedianEven", aggregate: stats.MedianMoney, amounts: usd("4.00", "1.00", "3.00", "2.01"), expected: "2.50"},
+		{name: "MedianEvenRounded", aggregate: stats.MedianMoney, amounts: usd("4.00", "1.00", "3.00", "2.03"), expected: "2.52"},
+		{name: "Percentile", aggregate: func(amounts []money.Money) (money.Money, error) { return stats.PercentileMoney(amounts, 90) }, amounts: usd("1.00", "2.00", "3.00", "4.00", "5.00"), expected: "4.60"},
+		{name: "StdDev", aggregate: stats.StdDevMoney, amounts: usd("2.00", "4.00", "4.00", "4.00", "5.00", "5.00", "7.00", "9.00"), expected: "2.00"},
+		{name: "SampleStdDev", aggregate: stats.SampleStdDevMoney, amounts: usd("1.00", "2.00", "3.00", "4.00"), expected: "1.29"},
+		{name: "Min", aggregate: stats.MinMoney, amounts: usd("4.00", "-1.50", "3.00"), expected: "-1.50"},
+		{name: "Max", aggregate: stats.MaxMoney, amounts: usd("4.00", "-1.50", "3.00"), expected: "4.00"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			result, err := tt.aggregate(tt.amounts)
+			is.NoErr(err)
+			is.Equal(result.Decimal().String(), tt.expected)
+			is.Equal(result.Currency(), money.USD)
+
+			_, err = tt.aggregate(nil)
+			is.True(errors.Is(err, stats.ErrEmpty))
+
+			_, err = tt.aggregate(append(tt.amounts, money.NewMoney(100, money.EUR)))
+			var incompatible metric.ErrIncompatibleMetric
+			is.True(errors.As(err, &incompatible))
+		})
+	}
+}
+
+func TestSumMoneyExact(t *testing.T) {
+	is := isser.New(t)
+
+	amounts := make([]money.Money, 1000)
+	for i := range amounts {
+		amounts[i] = money.NewMoneyFromDecimal(decimal.MustParse("0.01"), money.USD)
+	}
+
+	total, err := stats.SumMoney(amounts)
+	is.NoErr(err)
+	is.Equal(total.Decimal().String(), "10.00")
+}
+
+func TestWeightedMeanMoney(t *testing.T) {
+	is := isser.New(t)
+
+	mean, err := stats.WeightedMeanMoney(usd("10.00", "20.00", "30.01"), []float64{1, 1, 1})
+	is.NoErr(err)
+	is.Equal(mean.Decimal().String(), "20.00")
+
+	mean, err = stats.WeightedMeanMoney(usd("10.00", "20.00"), []float64{3, 1})
+	is.NoErr(err)
+	is.Equal(mean.Decimal().String(), "12.50")
+
+	_, err = stats.WeightedMeanMoney(usd("10.00", "20.00"), []float64{1})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+
+	_, err = stats.WeightedMeanMoney(usd("10.00", "20.00"), []float64{1, -1})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+
+	_, err = stats.WeightedMeanMoney(usd("10.00", "20.00"), []float64{0, 0})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+}
+
+func TestVarianceMoney(t *testing.T) {
+	is := isser.New(t)
+
+	variance, err := stats.VarianceMoney(usd("2.00", "4.00", "4.00", "4.00", "5.00", "5.00", "7.00", "9.00"))
+	is.NoErr(err)
+	is.Equal(variance.Amount(), 4.0)
+	is.Equal(variance.Metric().Symbol(), "$²")
+
+	_, err = stats.PercentileMoney(usd("1.00"), 101)
+	is.True(errors.Is(err, stats.ErrInvalidPercentile))
+
+	_, err = stats.SampleStdDevMoney(usd("1.00"))
+	is.True(errors.Is(err, stats.ErrEmpty))
+}
@@ -0,0 +1,225 @@
+// Package stats aggregates slices of metric.Quantity and money.Money: sums, means, medians, extremes, percentiles,
+// variances and weighted means.
+//
+// Quantities in different units are converted to the unit of the first quantity through metric.UnitConverter,
+// so that the mean of 1 km and 500 m is 0.75 km. Money is never converted: its sums are exact, while its means,
+// percentiles and standard deviations are rounded to the Currency and its variance is a Quantity in the squared Currency.
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ErrEmpty             = errors.New("no values")
+	ErrInvalidPercentile = errors.New("invalid percentile")
+	ErrInvalidWeights    = errors.New("invalid weights")
+)
+
+// amounts returns the amounts of the quantities in the Metric of the first one, converting the others if needed.
+func amounts(quantities []metric.Quantity) ([]float64, metric.Metric, error) {
+	if len(quantities) == 0 {
+		return nil, nil, ErrEmpty
+	}
+
+	common := quantities[0].Metric()
+	result := make([]float64, len(quantities))
+	for i, q := range quantities {
+		if q.Metric() == common {
+			result[i] = q.Amount()
+			continue
+		}
+
+		unit, ok := common.(metric.Unit)
+		if !ok {
+			return nil, nil, metric.ErrIncompatibleMetric{M1: common, M2: q.Metric()}
+		}
+		converted, err := metric.UnitConverter.Convert(q, unit)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting %s to %s: %w", q, unit, err)
+		}
+		result[i] = converted.Amount()
+	}
+
+	return result, common, nil
+}
+
+// linearAmounts is like amounts but rejects logarithmic levels, whose amounts cannot be summed.
+func linearAmounts(quantities []metric.Quantity) ([]float64, metric.Metric, error) {
+	values, common, err := amounts(quantities)
+	if err != nil {
+		return nil, nil, err
+	}
+	if metric.IsLevel(common) {
+		return nil, nil, fmt.Errorf("%w: use metric.SumLevels for %s", metric.ErrLogarithmicArithmetic, common)
+	}
+	return values, common, nil
+}
+
+// Sum returns the sum of the quantities in the unit of the first one.
+func Sum(quantities []metric.Quantity) (metric.Quantity, error) {
+	values, common, err := linearAmounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	return metric.NewQuantity(sum(values), common), nil
+}
+
+// Mean returns the arithmetic mean of the quantities in the unit of the first one.
+func Mean(quantities []metric.Quantity) (metric.Quantity, error) {
+	values, common, err := linearAmounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	return metric.NewQuantity(sum(values)/float64(len(values)), common), nil
+}
+
+// WeightedMean returns the mean of the quantities weighted by the non-negative weights, in the unit of the first quantity.
+func WeightedMean(quantities []metric.Quantity, weights []float64) (metric.Quantity, error) {
+	if len(weights) != len(quantities) {
+		return nil, fmt.Errorf("%w: %d weights for %d values", ErrInvalidWeights, len(weights), len(quantities))
+	}
+
+	values, common, err := linearAmounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, weightTotal float64
+	for i, weight := range weights {
+		if weight < 0 || math.IsNaN(weight) {
+			return nil, fmt.Errorf("%w: negative weight %v", ErrInvalidWeights, weight)
+		}
+		total += values[i] * weight
+		weightTotal += weight
+	}
+	if weightTotal == 0 {
+		return nil, fmt.Errorf("%w: the weights add up to zero", ErrInvalidWeights)
+	}
+
+	return metric.NewQuantity(total/weightTotal, common), nil
+}
+
+// Median returns the median of the quantities in the unit of the first one.
+func Median(quantities []metric.Quantity) (metric.Quantity, error) {
+	return Percentile(quantities, 50)
+}
+
+// Percentile returns the p-th percentile of the quantities, for p between 0 and 100, in the unit of the first one.
+// It interpolates linearly between the closest ranks, so that the 50th percentile is the median.
+func Percentile(quantities []metric.Quantity, p float64) (metric.Quantity, error) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPercentile, p)
+	}
+
+	values, common, err := amounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+	sort.Float64s(values)
+
+	rank := p / 100 * float64(len(values)-1)
+	below := int(math.Floor(rank))
+	if below == len(values)-1 {
+		return metric.NewQuantity(values[below], common), nil
+	}
+
+	fraction := rank - float64(below)
+	return metric.NewQuantity(values[below]+fraction*(values[below+1]-values[below]), common), nil
+}
+
+// Min returns the smallest of the quantities, in its own unit.
+func Min(quantities []metric.Quantity) (metric.Quantity, error) {
+	return extreme(quantities, func(a, b float64) bool { return a < b })
+}
+
+// Max returns the largest of the quantities, in its own unit.
+func Max(quantities []metric.Quantity) (metric.Quantity, error) {
+	return extreme(quantities, func(a, b float64) bool { return a > b })
+}
+
+func extreme(quantities []metric.Quantity, better func(a, b float64) bool) (metric.Quantity, error) {
+	values, _, err := amounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	best := 0
+	for i, value := range values {
+		if better(value, values[best]) {
+			best = i
+		}
+	}
+	return quantities[best], nil
+}
+
+// Variance returns the population variance of the quantities in the square of the unit of the first one, e.g. m² for meters.
+func Variance(quantities []metric.Quantity) (metric.Quantity, error) {
+	return variance(quantities, 0)
+}
+
+// SampleVariance returns the sample variance of the quantities, with Bessel's correction, in the square of the unit of the first one.
+func SampleVariance(quantities []metric.Quantity) (metric.Quantity, error) {
+	if len(quantities) == 1 {
+		return nil, fmt.Errorf("%w: the sample variance requires at least 2 values", ErrEmpty)
+	}
+	return variance(quantities, 1)
+}
+
+// StdDev returns the population standard deviation of the quantities in the unit of the first one.
+func StdDev(quantities []metric.Quantity) (metric.Quantity, error) {
+	return stdDev(quantities, Variance)
+}
+
+// SampleStdDev returns the sample standard deviation of the quantities in the unit of the first one.
+func SampleStdDev(quantities []metric.Quantity) (metric.Quantity, error) {
+	return stdDev(quantities, SampleVariance)
+}
+
+func stdDev(quantities []metric.Quantity, variance func([]metric.Quantity) (metric.Quantity, error)) (metric.Quantity, error) {
+	v, err := variance(quantities)
+	if err != nil {
+		return nil, err
+	}
+	return metric.NewQuantity(math.Sqrt(v.Amount()), quantities[0].Metric()), nil
+}
+
+func variance(quantities []metric.Quantity, correction int) (metric.Quantity, error) {
+	values, common, err := linearAmounts(quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	mean := sum(values) / float64(len(values))
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+
+	return metric.NewQuantity(squares/float64(len(values)-correction), squared(common)), nil
+}
+
+// sum adds the values with Kahan summation, to limit rounding errors over long slices.
+func sum(values []float64) float64 {
+	var total, compensation float64
+	for _, value := range values {
+		y := value - compensation
+		t := total + y
+		compensation = (t - total) - y
+		total = t
+	}
+	return total
+}
+
+// squared returns the square of the unit as given by metric.UnitOf: metric.One for dimensionless units,
+// a registered DerivedUnit such as metric.Area, or a DerivedUnit created on first use.
+func squared(unit metric.Metric) metric.Metric {
+	return metric.UnitOf(metric.ExpandTerms(unit, 2)...)
+}
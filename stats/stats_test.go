@@ -0,0 +1,136 @@
+package stats_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/stats"
+	isser "github.com/matryer/is"
+)
+
+func quantities(unit metric.Metric, amounts ...float64) []metric.Quantity {
+	result := make([]metric.Quantity, len(amounts))
+	for i, amount := range amounts {
+		result[i] = metric.NewQuantity(amount, unit)
+	}
+	return result
+}
+
+func TestAggregates(t *testing.T) {
+	samples := quantities(metric.Second, 2, 4, 4, 4, 5, 5, 7, 9)
+
+	tests := []struct {
+		name      string
+		aggregate func([]metric.Quantity) (metric.Quantity, error)
+		expected  metric.Quantity
+	}{
+		{name: "Sum", aggregate: stats.Sum, expected: metric.NewQuantity(40, metric.Second)},
+		{name: "Mean", aggregate: stats.Mean, expected: metric.NewQuantity(5, metric.Second)},
+		{name: "Median", aggregate: stats.Median, expected: metric.NewQuantity(4.5, metric.Second)},
+		{name: "Min", aggregate: stats.Min, expected: metric.NewQuantity(2, metric.Second)},
+		{name: "Max", aggregate: stats.Max, expected: metric.NewQuantity(9, metric.Second)},
+		{name: "StdDev", aggregate: stats.StdDev, expected: metric.NewQuantity(2, metric.Second)},
+		{name: "SampleStdDev", aggregate: stats.SampleStdDev, expected: metric.NewQuantity(math.Sqrt(32.0/7), metric.Second)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			result, err := tt.aggregate(samples)
+			is.NoErr(err)
+			is.Equal(result.Amount(), tt.expected.Amount())
+			is.Equal(result.Metric(), tt.expected.Metric())
+
+			_, err = tt.aggregate(nil)
+			is.True(errors.Is(err, stats.ErrEmpty))
+		})
+	}
+}
+
+func TestMixedUnits(t *testing.T) {
+	is := isser.New(t)
+
+	mixed := []metric.Quantity{
+		metric.NewQuantity(1, metric.Hour),
+		metric.NewQuantity(30, metric.Minute),
+		metric.NewQuantity(5400, metric.Second),
+	}
+
+	sum, err := stats.Sum(mixed)
+	is.NoErr(err)
+	is.Equal(sum.String(), "3 h")
+
+	mean, err := stats.Mean(mixed)
+	is.NoErr(err)
+	is.Equal(mean.String(), "1 h")
+
+	longest, err := stats.Max(mixed)
+	is.NoErr(err)
+	is.Equal(longest.String(), "5400 s")
+
+	_, err = stats.Sum(append(mixed, metric.NewQuantity(1, metric.Meter)))
+	is.True(errors.Is(err, metric.ErrNoConversion))
+}
+
+func TestPercentile(t *testing.T) {
+	is := isser.New(t)
+
+	samples := quantities(metric.Meter, 15, 20, 35, 40, 50)
+	for p, expected := range map[float64]float64{0: 15, 25: 20, 40: 29, 50: 35, 100: 50} {
+		result, err := stats.Percentile(samples, p)
+		is.NoErr(err)
+		is.Equal(result.Amount(), expected)
+	}
+
+	_, err := stats.Percentile(samples, 101)
+	is.True(errors.Is(err, stats.ErrInvalidPercentile))
+
+	single, err := stats.Percentile(samples[:1], 90)
+	is.NoErr(err)
+	is.Equal(single.Amount(), 15.0)
+}
+
+func TestVariance(t *testing.T) {
+	is := isser.New(t)
+
+	variance, err := stats.Variance(quantities(metric.Meter, 2, 4, 4, 4, 5, 5, 7, 9))
+	is.NoErr(err)
+	is.Equal(variance.Amount(), 4.0)
+	is.Equal(variance.Metric(), metric.Area)
+
+	variance, err = stats.SampleVariance(quantities(metric.Second, 1, 2, 3, 4))
+	is.NoErr(err)
+	is.Equal(variance.Amount(), 5.0/3)
+	is.Equal(variance.Metric().Symbol(), "s²")
+
+	again, err := stats.Variance(quantities(metric.Second, 1, 3))
+	is.NoErr(err)
+	is.Equal(again.Metric(), variance.Metric()) // the squared unit is shared
+
+	_, err = stats.SampleVariance(quantities(metric.Second, 1))
+	is.True(errors.Is(err, stats.ErrEmpty))
+
+	_, err = stats.Variance(quantities(metric.DecibelMilliwatt, 1, 2))
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+}
+
+func TestWeightedMean(t *testing.T) {
+	is := isser.New(t)
+
+	mean, err := stats.WeightedMean(quantities(metric.Kelvin, 10, 20, 40), []float64{1, 2, 1})
+	is.NoErr(err)
+	is.Equal(mean.String(), "22.5 K")
+
+	_, err = stats.WeightedMean(quantities(metric.Kelvin, 10, 20), []float64{1})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+
+	_, err = stats.WeightedMean(quantities(metric.Kelvin, 10, 20), []float64{1, -1})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+
+	_, err = stats.WeightedMean(quantities(metric.Kelvin, 10, 20), []float64{0, 0})
+	is.True(errors.Is(err, stats.ErrInvalidWeights))
+}
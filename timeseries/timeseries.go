@@ -0,0 +1,323 @@
+// Package timeseries records quantities over time, e.g. the readings of a sensor, and aggregates, resamples,
+// differentiates and integrates them.
+//
+// A Series holds quantities of a single dimension: every appended Quantity is converted to the unit of the Series
+// through metric.UnitConverter. Appends are safe for concurrent use by multiple producers, and the points
+// are kept in time order regardless of the order of the appends.
+package timeseries
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IAmRadek/metric"
+)
+
+var (
+	ErrDuplicateTime = errors.New("duplicate time")
+	ErrTooFewPoints  = errors.New("too few points")
+	ErrInvalidWindow = errors.New("invalid window")
+)
+
+// Point is a Quantity recorded at a time.
+type Point struct {
+	Time     time.Time
+	Quantity metric.Quantity
+}
+
+// Series is a time-ordered sequence of quantities in one unit.
+type Series struct {
+	mu     sync.RWMutex
+	unit   metric.Unit
+	points []Point
+}
+
+// New creates an empty Series of quantities in the unit.
+func New(unit metric.Unit) *Series {
+	return &Series{unit: unit}
+}
+
+// Unit returns the unit of the quantities of the Series.
+func (s *Series) Unit() metric.Unit {
+	return s.unit
+}
+
+// Len returns the number of points.
+func (s *Series) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.points)
+}
+
+// Points returns a copy of the points in time order.
+func (s *Series) Points() []Point {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return append([]Point(nil), s.points...)
+}
+
+// Append records the Quantity at the time, converting it to the unit of the Series.
+// It returns metric.ErrNoConversion for quantities of another dimension and ErrDuplicateTime if the time is already recorded.
+func (s *Series) Append(t time.Time, quantity metric.Quantity) error {
+	if quantity.Metric() != s.unit {
+		converted, err := metric.UnitConverter.Convert(quantity, s.unit)
+		if err != nil {
+			return fmt.Errorf("converting %s to %s: %w", quantity, s.unit, err)
+		}
+		quantity = converted
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := sort.Search(len(s.points), func(i int) bool { return !s.points[i].Time.Before(t) })
+	if i < len(s.points) && s.points[i].Time.Equal(t) {
+		return fmt.Errorf("%w: %s", ErrDuplicateTime, t)
+	}
+
+	s.points = append(s.points, Point{})
+	copy(s.points[i+1:], s.points[i:])
+	s.points[i] = Point{Time: t, Quantity: quantity}
+
+	return nil
+}
+
+// Aggregate reduces the quantities of a window to one, e.g. stats.Mean or stats.Max.
+type Aggregate func([]metric.Quantity) (metric.Quantity, error)
+
+// Tumbling aggregates the points in consecutive, non-overlapping windows of the size, aligned with time.Time.Truncate.
+// The points of the result are at the start of their windows; windows without points are skipped.
+func (s *Series) Tumbling(size time.Duration, aggregate Aggregate) (*Series, error) {
+	return s.Sliding(size, size, aggregate)
+}
+
+// Sliding aggregates the points in windows of the size starting every step, aligned with time.Time.Truncate.
+// The points of the result are at the start of their windows; windows without points are skipped.
+func (s *Series) Sliding(size, step time.Duration, aggregate Aggregate) (*Series, error) {
+	if size <= 0 || step <= 0 {
+		return nil, fmt.Errorf("%w: size %s and step %s must be positive", ErrInvalidWindow, size, step)
+	}
+
+	points := s.Points()
+	if len(points) == 0 {
+		return nil, ErrTooFewPoints
+	}
+
+	var result *Series
+	last := points[len(points)-1].Time
+	for start := points[0].Time.Truncate(step); !start.After(last); start = start.Add(step) {
+		window := between(points, start, start.Add(size))
+		if len(window) == 0 {
+			continue
+		}
+
+		quantities := make([]metric.Quantity, len(window))
+		for i, p := range window {
+			quantities[i] = p.Quantity
+		}
+		aggregated, err := aggregate(quantities)
+		if err != nil {
+			return nil, fmt.Errorf("aggregating the window at %s: %w", start, err)
+		}
+
+		if result == nil {
+			unit, ok := aggregated.Metric().(metric.Unit)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", metric.ErrMetricIsNotUnit, aggregated.Metric())
+			}
+			result = New(unit)
+		}
+		if err := result.Append(start, aggregated); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// between returns the points at or after from and before to.
+func between(points []Point, from, to time.Time) []Point {
+	i := sort.Search(len(points), func(i int) bool { return !points[i].Time.Before(from) })
+	j := sort.Search(len(points), func(j int) bool { return !points[j].Time.Before(to) })
+	return points[i:j]
+}
+
+// Interpolation estimates the quantities between points.
+type Interpolation int
+
+const (
+	// Linear interpolates linearly between the surrounding points.
+	Linear Interpolation = iota
+	// Previous holds the quantity of the last point at or before the time.
+	Previous
+	// Nearest takes the quantity of the closest point, the earlier one on ties.
+	Nearest
+)
+
+// Resample returns a Series with a point every interval, aligned with time.Time.Truncate,
+// between the first and the last points, estimating the quantities with the interpolation.
+func (s *Series) Resample(interval time.Duration, interpolation Interpolation) (*Series, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w: interval %s must be positive", ErrInvalidWindow, interval)
+	}
+
+	points := s.Points()
+	if len(points) == 0 {
+		return nil, ErrTooFewPoints
+	}
+
+	first, last := points[0].Time, points[len(points)-1].Time
+	start := first.Truncate(interval)
+	if start.Before(first) {
+		start = start.Add(interval)
+	}
+
+	result := New(s.unit)
+	for t := start; !t.After(last); t = t.Add(interval) {
+		i := sort.Search(len(points), func(i int) bool { return !points[i].Time.Before(t) })
+		amount := points[i].Quantity.Amount()
+
+		if !points[i].Time.Equal(t) {
+			before, after := points[i-1], points[i]
+			switch interpolation {
+			case Linear:
+				fraction := float64(t.Sub(before.Time)) / float64(after.Time.Sub(before.Time))
+				amount = before.Quantity.Amount() + fraction*(after.Quantity.Amount()-before.Quantity.Amount())
+			case Previous:
+				amount = before.Quantity.Amount()
+			case Nearest:
+				if t.Sub(before.Time) <= after.Time.Sub(t) {
+					amount = before.Quantity.Amount()
+				}
+			default:
+				return nil, fmt.Errorf("unknown interpolation %d", interpolation)
+			}
+		}
+
+		if err := result.Append(t, metric.NewQuantity(amount, s.unit)); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Rate returns the rate of change between consecutive points, at the later of them, in the unit of the Series
+// per second, e.g. metric.Speed for metric.Meter or "W/s" for metric.Watt as given by metric.DivideUnits.
+func (s *Series) Rate() (*Series, error) {
+	points := s.Points()
+	if len(points) < 2 {
+		return nil, fmt.Errorf("%w: the rate requires at least 2 points", ErrTooFewPoints)
+	}
+	if metric.IsLevel(s.unit) {
+		return nil, fmt.Errorf("%w: cannot differentiate %s", metric.ErrLogarithmicArithmetic, s.unit)
+	}
+
+	result := New(perSecond(s.unit, -1))
+	for i := 1; i < len(points); i++ {
+		change := points[i].Quantity.Amount() - points[i-1].Quantity.Amount()
+		elapsed := points[i].Time.Sub(points[i-1].Time).Seconds()
+
+		if err := result.Append(points[i].Time, metric.NewQuantity(change/elapsed, result.unit)); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Integrate returns the integral of the quantities over time with the trapezoidal rule, in the unit of the Series
+// times seconds, e.g. metric.Joule for metric.Watt or "m*s" for metric.Meter as given by metric.MultiplyUnits.
+func (s *Series) Integrate() (metric.Quantity, error) {
+	points := s.Points()
+	if len(points) < 2 {
+		return nil, fmt.Errorf("%w: the integral requires at least 2 points", ErrTooFewPoints)
+	}
+	if metric.IsLevel(s.unit) {
+		return nil, fmt.Errorf("%w: cannot integrate %s", metric.ErrLogarithmicArithmetic, s.unit)
+	}
+
+	var total float64
+	for i := 1; i < len(points); i++ {
+		mean := (points[i].Quantity.Amount() + points[i-1].Quantity.Amount()) / 2
+		total += mean * points[i].Time.Sub(points[i-1].Time).Seconds()
+	}
+
+	return metric.NewQuantity(total, perSecond(s.unit, 1)), nil
+}
+
+var (
+	// The units created for rates and integrals without a registered unit, so that series of the same unit share them.
+	mu           sync.Mutex
+	createdUnits = make(map[metric.Unit]map[int]metric.Unit)
+)
+
+// perSecond returns the unit multiplied by the second raised to the exponent, 1 or -1: a registered unit if any,
+// e.g. metric.Speed for the meter per second or metric.Joule for the watt second, or a DerivedUnit created on first use.
+func perSecond(unit metric.Unit, exponent int) metric.Unit {
+	if derived, ok := metric.FindDerivedUnit(metric.NewDerivedUnitTerm(unit, 1), metric.NewDerivedUnitTerm(metric.Second, exponent)); ok {
+		return derived
+	}
+
+	if derived, ok := unit.(metric.DerivedUnit); ok && len(derived.Terms()) > 0 {
+		if unit, ok := withSeconds(derived.Terms(), exponent); ok {
+			return unit
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if created, ok := createdUnits[unit][exponent]; ok {
+		return created
+	}
+
+	var created metric.Unit
+	if exponent < 0 {
+		created = metric.DivideUnits(unit, metric.Second)
+	} else {
+		created = metric.MultiplyUnits(unit, metric.Second)
+	}
+
+	if createdUnits[unit] == nil {
+		createdUnits[unit] = make(map[int]metric.Unit)
+	}
+	createdUnits[unit][exponent] = created
+
+	return created
+}
+
+// withSeconds returns the registered unit of the terms with the exponent of the second changed by the exponent,
+// e.g. metric.Joule for the terms of metric.Watt and 1, or metric.Meter for the terms of metric.Speed and 1.
+func withSeconds(terms []metric.DerivedUnitTerm, exponent int) (metric.Unit, bool) {
+	var result []metric.DerivedUnitTerm
+	found := false
+	for _, term := range terms {
+		if term.Metric() == metric.Second {
+			found = true
+			if term.Exponent()+exponent != 0 {
+				result = append(result, metric.NewDerivedUnitTerm(metric.Second, term.Exponent()+exponent))
+			}
+			continue
+		}
+		result = append(result, term)
+	}
+	if !found {
+		return nil, false
+	}
+
+	switch {
+	case len(result) == 0:
+		return metric.One, true
+	case len(result) == 1 && result[0].Exponent() == 1:
+		unit, ok := result[0].Metric().(metric.Unit)
+		return unit, ok
+	}
+
+	return metric.FindDerivedUnit(result...)
+}
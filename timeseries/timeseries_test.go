@@ -0,0 +1,201 @@
+package timeseries_test
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/stats"
+	"github.com/IAmRadek/metric/timeseries"
+	isser "github.com/matryer/is"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(seconds int) time.Time {
+	return epoch.Add(time.Duration(seconds) * time.Second)
+}
+
+func series(t *testing.T, unit metric.Unit, amounts map[int]float64) *timeseries.Series {
+	t.Helper()
+
+	s := timeseries.New(unit)
+	for seconds, amount := range amounts {
+		if err := s.Append(at(seconds), metric.NewQuantity(amount, unit)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func amounts(s *timeseries.Series) map[int]float64 {
+	result := make(map[int]float64)
+	for _, p := range s.Points() {
+		result[int(p.Time.Sub(epoch)/time.Second)] = p.Quantity.Amount()
+	}
+	return result
+}
+
+func TestAppend(t *testing.T) {
+	is := isser.New(t)
+
+	s := timeseries.New(metric.Second)
+	is.NoErr(s.Append(at(20), metric.NewQuantity(30, metric.Second)))
+	is.NoErr(s.Append(at(10), metric.NewQuantity(2, metric.Minute)))
+
+	points := s.Points()
+	is.Equal(len(points), 2)
+	is.Equal(points[0].Time, at(10))
+	is.Equal(points[0].Quantity.Amount(), 120.0)
+	is.Equal(points[0].Quantity.Metric(), metric.Second)
+	is.Equal(points[1].Time, at(20))
+
+	err := s.Append(at(10), metric.NewQuantity(1, metric.Second))
+	is.True(errors.Is(err, timeseries.ErrDuplicateTime))
+
+	err = s.Append(at(30), metric.NewQuantity(1, metric.Meter))
+	is.True(errors.Is(err, metric.ErrNoConversion))
+	is.Equal(s.Len(), 2)
+}
+
+func TestConcurrentAppend(t *testing.T) {
+	is := isser.New(t)
+
+	const producers, perProducer = 8, 100
+	s := timeseries.New(metric.Second)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := s.Append(at(i*producers+p), metric.NewQuantity(1, metric.Second)); err != nil {
+					t.Error(err)
+				}
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	points := s.Points()
+	is.Equal(len(points), producers*perProducer)
+	for i := 1; i < len(points); i++ {
+		is.True(points[i-1].Time.Before(points[i].Time))
+	}
+}
+
+func TestTumbling(t *testing.T) {
+	is := isser.New(t)
+
+	s := series(t, metric.Second, map[int]float64{0: 1, 5: 3, 10: 10, 30: 7, 35: 9})
+
+	mean, err := s.Tumbling(10*time.Second, stats.Mean)
+	is.NoErr(err)
+	is.Equal(mean.Unit(), metric.Second)
+	is.Equal(amounts(mean), map[int]float64{0: 2, 10: 10, 30: 8})
+
+	_, err = s.Tumbling(0, stats.Mean)
+	is.True(errors.Is(err, timeseries.ErrInvalidWindow))
+
+	_, err = timeseries.New(metric.Second).Tumbling(time.Second, stats.Mean)
+	is.True(errors.Is(err, timeseries.ErrTooFewPoints))
+}
+
+func TestSliding(t *testing.T) {
+	is := isser.New(t)
+
+	s := series(t, metric.Second, map[int]float64{0: 1, 5: 3, 10: 10, 15: 6})
+
+	maximum, err := s.Sliding(10*time.Second, 5*time.Second, stats.Max)
+	is.NoErr(err)
+	is.Equal(amounts(maximum), map[int]float64{0: 3, 5: 10, 10: 10, 15: 6})
+}
+
+func TestResample(t *testing.T) {
+	s := series(t, metric.Second, map[int]float64{1: 10, 4: 40, 6: 20})
+
+	tests := []struct {
+		name          string
+		interpolation timeseries.Interpolation
+		expected      map[int]float64
+	}{
+		{name: "Linear", interpolation: timeseries.Linear, expected: map[int]float64{2: 20, 4: 40, 6: 20}},
+		{name: "Previous", interpolation: timeseries.Previous, expected: map[int]float64{2: 10, 4: 40, 6: 20}},
+		{name: "Nearest", interpolation: timeseries.Nearest, expected: map[int]float64{2: 10, 4: 40, 6: 20}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			resampled, err := s.Resample(2*time.Second, tt.interpolation)
+			is.NoErr(err)
+			is.Equal(resampled.Unit(), metric.Second)
+			is.Equal(amounts(resampled), tt.expected)
+		})
+	}
+}
+
+func TestRate(t *testing.T) {
+	is := isser.New(t)
+
+	distance := series(t, metric.Meter, map[int]float64{0: 0, 10: 50, 20: 150})
+
+	speed, err := distance.Rate()
+	is.NoErr(err)
+	is.Equal(speed.Unit(), metric.Speed)
+	is.Equal(amounts(speed), map[int]float64{10: 5, 20: 10})
+
+	power := series(t, metric.Watt, map[int]float64{0: 100, 2: 110})
+	change, err := power.Rate()
+	is.NoErr(err)
+	is.Equal(change.Unit().Symbol(), "W/s")
+	is.Equal(amounts(change), map[int]float64{2: 5})
+
+	again, err := power.Rate()
+	is.NoErr(err)
+	is.Equal(again.Unit(), change.Unit())
+
+	minutes := series(t, metric.Minute, map[int]float64{0: 1, 60: 2})
+	perSecond, err := minutes.Rate()
+	is.NoErr(err)
+	is.Equal(perSecond.Unit().Symbol(), "min/s")
+	is.Equal(amounts(perSecond), map[int]float64{60: 1.0 / 60})
+
+	_, err = series(t, metric.Meter, map[int]float64{0: 1}).Rate()
+	is.True(errors.Is(err, timeseries.ErrTooFewPoints))
+
+	_, err = series(t, metric.DecibelMilliwatt, map[int]float64{0: 1, 1: 2}).Rate()
+	is.True(errors.Is(err, metric.ErrLogarithmicArithmetic))
+}
+
+func TestIntegrate(t *testing.T) {
+	is := isser.New(t)
+
+	power := series(t, metric.Watt, map[int]float64{0: 100, 10: 200, 20: 200})
+
+	energy, err := power.Integrate()
+	is.NoErr(err)
+	is.Equal(energy.Metric(), metric.Joule)
+	is.Equal(energy.Amount(), 3500.0)
+
+	speed := series(t, metric.Speed, map[int]float64{0: 2, 60: 4})
+	distance, err := speed.Integrate()
+	is.NoErr(err)
+	is.Equal(distance.Metric(), metric.Meter)
+	is.True(math.Abs(distance.Amount()-180) < 1e-9)
+
+	frequency := metric.NewDerivedUnit("per second", "Events per second", "/s", nil, metric.NewDerivedUnitTerm(metric.Second, -1))
+	events, err := series(t, frequency, map[int]float64{0: 3, 10: 3}).Integrate()
+	is.NoErr(err)
+	is.Equal(events.Metric(), metric.One)
+	is.Equal(events.Amount(), 30.0)
+
+	_, err = timeseries.New(metric.Watt).Integrate()
+	is.True(errors.Is(err, timeseries.ErrTooFewPoints))
+}
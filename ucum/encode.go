@@ -0,0 +1,111 @@
+package ucum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric"
+)
+
+// Encode returns the canonical UCUM code of the unit, e.g. "m/s" for metric.Speed or "kBy" for metric.Kilobyte.
+// Derived units without a code of their own are encoded from their terms.
+func Encode(unit metric.Metric) (string, error) {
+	if code, ok := lookupCode(unit); ok && !isComposite(code) {
+		return code, nil
+	}
+
+	terms, err := flatten(unit, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeTerms(combine(terms))
+}
+
+// isComposite returns true if the code is a product or quotient of units.
+func isComposite(code string) bool {
+	return strings.ContainsAny(code, "./")
+}
+
+// isRaisable returns true if the code can be followed by an exponent,
+// which excludes numeric factors and annotated units.
+func isRaisable(code string) bool {
+	return !strings.ContainsAny(code, "{0123456789")
+}
+
+// flatten expands the unit into terms of units with codes that are neither products nor quotients.
+func flatten(unit metric.Metric, exponent int) ([]term, error) {
+	code, ok := lookupCode(unit)
+	if ok && !isComposite(code) && (exponent == 1 || exponent == -1 || isRaisable(code)) {
+		return []term{{unit.(metric.Unit), exponent}}, nil
+	}
+
+	derived, ok := unit.(metric.DerivedUnit)
+	if !ok || len(derived.Terms()) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotEncodable, unit.Name())
+	}
+
+	var terms []term
+	for _, t := range derived.Terms() {
+		expanded, err := flatten(t.Metric(), t.Exponent()*exponent)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, expanded...)
+	}
+
+	return terms, nil
+}
+
+// encodeTerms encodes the terms with the multiplied units first, followed by the divisors, e.g. "kg.m/s2".
+func encodeTerms(terms []term) (string, error) {
+	var b strings.Builder
+
+	for _, t := range terms {
+		if t.exponent < 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		if err := writeTerm(&b, t.unit, t.exponent); err != nil {
+			return "", err
+		}
+	}
+
+	if b.Len() == 0 && len(terms) == 0 {
+		return "1", nil
+	}
+
+	for _, t := range terms {
+		if t.exponent > 0 {
+			continue
+		}
+		b.WriteByte('/')
+		if err := writeTerm(&b, t.unit, -t.exponent); err != nil {
+			return "", err
+		}
+	}
+
+	return b.String(), nil
+}
+
+func writeTerm(b *strings.Builder, unit metric.Unit, exponent int) error {
+	code, ok := lookupCode(unit)
+	if !ok || exponent != 1 && !isRaisable(code) {
+		return fmt.Errorf("%w: %s", ErrNotEncodable, unit.Name())
+	}
+
+	// Annotations follow the exponent, e.g. cm2{skin}.
+	simple, annotation, _ := strings.Cut(code, "{")
+	b.WriteString(simple)
+	if exponent != 1 {
+		b.WriteString(strconv.Itoa(exponent))
+	}
+	if annotation != "" {
+		b.WriteString("{" + annotation)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package ucum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/imperial"
+	"github.com/IAmRadek/metric/ucum"
+	isser "github.com/matryer/is"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		unit     metric.Metric
+		expected string
+	}{
+		{name: "Meter", unit: metric.Meter, expected: "m"},
+		{name: "Kilogram", unit: metric.Kilogram, expected: "kg"},
+		{name: "Celsius", unit: metric.Celsius, expected: "Cel"},
+		{name: "Microsecond", unit: metric.Microsecond, expected: "us"},
+		{name: "Speed", unit: metric.Speed, expected: "m/s"},
+		{name: "Joule", unit: metric.Joule, expected: "J"},
+		{name: "Area", unit: metric.Area, expected: "m2"},
+		{name: "BytePerSecond", unit: metric.BytePerSecond, expected: "By/s"},
+		{name: "Mebibyte", unit: metric.Mebibyte, expected: "MiBy"},
+		{name: "Percent", unit: metric.Percent, expected: "%"},
+		{name: "Arcminute", unit: metric.Arcminute, expected: "'"},
+		{name: "Liter", unit: ucum.Liter, expected: "L"},
+		{name: "Milliequivalent", unit: ucum.MustParse("meq"), expected: "meq"},
+		{name: "Pound", unit: imperial.Pound, expected: "[lb_av]"},
+		{name: "LiterAlias", unit: ucum.MustParse("dl"), expected: "dL"},
+		{name: "Product", unit: metric.NewDerivedUnit("", "", "", nil, metric.NewDerivedUnitTerm(metric.Kilogram, 1), metric.NewDerivedUnitTerm(metric.Speed, 2)), expected: "kg.m2/s2"},
+		{name: "Reciprocal", unit: metric.NewDerivedUnit("", "", "", nil, metric.NewDerivedUnitTerm(metric.Second, -1)), expected: "/s"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			code, err := ucum.Encode(tt.unit)
+			is.NoErr(err)
+			is.Equal(code, tt.expected)
+		})
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	for _, expression := range []string{"mg/dL", "mm[Hg]", "{count}/min", "By/s", "kg.m/s3", "10*3/uL", "cm2{skin}", "mL{total}/h", "/min", "[IU]/L"} {
+		expression := expression
+		t.Run(expression, func(t *testing.T) {
+			is := isser.New(t)
+
+			code, err := ucum.Encode(ucum.MustParse(expression))
+			is.NoErr(err)
+			is.Equal(code, expression)
+		})
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	is := isser.New(t)
+
+	_, err := ucum.Encode(metric.NewBaseUnit("furlong", "The furlong is 660 feet", "fur", nil))
+	is.True(errors.Is(err, ucum.ErrNotEncodable))
+
+	_, err = ucum.Encode(metric.NewMetric("score", "A score", "pt"))
+	is.True(errors.Is(err, ucum.ErrNotEncodable))
+}
@@ -0,0 +1,393 @@
+package ucum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IAmRadek/metric"
+)
+
+// term is a unit raised to an exponent within a UCUM expression.
+type term struct {
+	unit     metric.Unit
+	exponent int
+}
+
+// Parse parses a case-sensitive UCUM expression such as "mg/dL", "kg.m/s2", "mm[Hg]", "{count}/min" or "10*3/uL".
+//
+// Simple units, optionally prefixed and raised to a power, are resolved to the units of the metric package or of this package.
+// Products and quotients resolve to a registered DerivedUnit with the same terms, e.g. "By/s" to metric.BytePerSecond,
+// and to a new DerivedUnit otherwise. Annotations such as {count} are kept as dimensionless units that are not
+// convertible to anything else, like the arbitrary units [IU] and [arb'U].
+// Parsing the same expression twice returns the same Unit.
+func Parse(expression string) (metric.Unit, error) {
+	p := &parser{input: expression}
+
+	if expression == "" {
+		return nil, fmt.Errorf("%w: empty expression", ErrSyntax)
+	}
+
+	terms, err := p.parseMainTerm()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected %q", p.input[p.pos])
+	}
+
+	return unitOf(combine(terms)), nil
+}
+
+// MustParse is like Parse but panics if the expression cannot be parsed.
+func MustParse(expression string) metric.Unit {
+	unit, err := Parse(expression)
+	if err != nil {
+		panic(err)
+	}
+	return unit
+}
+
+// Validate returns an error if the expression is not a supported UCUM expression.
+func Validate(expression string) error {
+	_, err := Parse(expression)
+	return err
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at position %d in %q", ErrSyntax, fmt.Sprintf(format, args...), p.pos, p.input)
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// parseMainTerm parses a term optionally preceded by a division, e.g. "/min".
+func (p *parser) parseMainTerm() ([]term, error) {
+	if p.peek() == '/' {
+		p.pos++
+		terms, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		return invert(terms), nil
+	}
+
+	return p.parseTerm()
+}
+
+// parseTerm parses components joined by "." (multiplication) and "/" (division), evaluated left to right.
+func (p *parser) parseTerm() ([]term, error) {
+	terms, err := p.parseComponent()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch p.peek() {
+		case '.':
+			p.pos++
+			next, err := p.parseComponent()
+			if err != nil {
+				return nil, err
+			}
+			terms = append(terms, next...)
+		case '/':
+			p.pos++
+			next, err := p.parseComponent()
+			if err != nil {
+				return nil, err
+			}
+			terms = append(terms, invert(next)...)
+		default:
+			return terms, nil
+		}
+	}
+}
+
+// parseComponent parses a parenthesized term, an annotation, a numeric factor or an annotatable simple unit.
+func (p *parser) parseComponent() ([]term, error) {
+	switch c := p.peek(); {
+	case c == '(':
+		p.pos++
+		terms, err := p.parseMainTerm()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return terms, nil
+	case c == '{':
+		annotation, err := p.parseAnnotation()
+		if err != nil {
+			return nil, err
+		}
+		return []term{{annotationUnit(annotation), 1}}, nil
+	case c >= '0' && c <= '9':
+		return p.parseFactor()
+	case c == 0:
+		return nil, p.errorf("unexpected end of expression")
+	}
+
+	return p.parseAnnotatable()
+}
+
+// parseAnnotatable parses a simple unit with an optional exponent and annotation, e.g. "cm2{skin}".
+func (p *parser) parseAnnotatable() ([]term, error) {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if c == '[' {
+			end := strings.IndexByte(p.input[p.pos:], ']')
+			if end < 0 {
+				return nil, p.errorf("missing closing bracket")
+			}
+			p.pos += end + 1
+			continue
+		}
+		if strings.IndexByte("./(){}+-0123456789", c) >= 0 {
+			break
+		}
+		if c <= ' ' || c >= 0x7f {
+			return nil, p.errorf("invalid character %q", c)
+		}
+		p.pos++
+	}
+
+	code := p.input[start:p.pos]
+	if code == "" {
+		return nil, p.errorf("expected a unit")
+	}
+
+	unit, err := lookupUnit(code)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := p.parseExponent()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() == '{' {
+		annotation, err := p.parseAnnotation()
+		if err != nil {
+			return nil, err
+		}
+		unit = annotatedUnit(combine([]term{{unit, exponent}}), annotation)
+		exponent = 1
+	}
+
+	return []term{{unit, exponent}}, nil
+}
+
+// parseExponent parses an optional signed integer exponent, returning 1 if there is none.
+func (p *parser) parseExponent() (int, error) {
+	start := p.pos
+	if c := p.peek(); c == '+' || c == '-' {
+		p.pos++
+	}
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+
+	if p.pos == start {
+		return 1, nil
+	}
+
+	exponent, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		return 0, p.errorf("invalid exponent %q", p.input[start:p.pos])
+	}
+	if exponent == 0 {
+		return 0, p.errorf("zero exponent")
+	}
+
+	return exponent, nil
+}
+
+// parseAnnotation parses "{...}" and returns its content.
+func (p *parser) parseAnnotation() (string, error) {
+	end := strings.IndexByte(p.input[p.pos:], '}')
+	if end < 0 {
+		return "", p.errorf("missing closing brace")
+	}
+
+	annotation := p.input[p.pos+1 : p.pos+end]
+	for i := 0; i < len(annotation); i++ {
+		if c := annotation[i]; c < '!' || c > '~' || c == '{' {
+			return "", p.errorf("invalid character %q in annotation", annotation[i])
+		}
+	}
+
+	p.pos += end + 1
+	return annotation, nil
+}
+
+// parseFactor parses a positive integer factor such as "1000", or a power of ten such as "10*3" or "10^-6".
+func (p *parser) parseFactor() ([]term, error) {
+	start := p.pos
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+	digits := p.input[start:p.pos]
+
+	if digits == "10" && (p.peek() == '*' || p.peek() == '^') {
+		p.pos++
+		exponentStart := p.pos
+		exponent, err := p.parseExponent()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos == exponentStart {
+			return nil, p.errorf("missing exponent of 10")
+		}
+		return []term{{factorUnit("10*" + strconv.Itoa(exponent)), 1}}, nil
+	}
+
+	if digits == "1" {
+		return []term{{metric.One, 1}}, nil
+	}
+
+	factor, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || factor == 0 {
+		return nil, p.errorf("invalid factor %q", digits)
+	}
+
+	return []term{{factorUnit(strconv.FormatUint(factor, 10)), 1}}, nil
+}
+
+// lookupUnit resolves a simple unit code, telling unknown atoms from unsupported atoms of the essence table
+// and from atoms that do not accept prefixes.
+func lookupUnit(code string) (metric.Unit, error) {
+	if unit, ok := units[code]; ok {
+		return unit, nil
+	}
+	if _, ok := unsupported[code]; ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAtom, code)
+	}
+
+	for _, p := range prefixes {
+		rest, ok := strings.CutPrefix(code, p.code)
+		if !ok {
+			continue
+		}
+		for _, atom := range atoms {
+			if atom.code == rest {
+				return nil, fmt.Errorf("%w: %q in %q", ErrNotMetric, rest, code)
+			}
+		}
+		if prefixable, ok := unsupported[rest]; ok {
+			if prefixable {
+				return nil, fmt.Errorf("%w: %q in %q", ErrUnsupportedAtom, rest, code)
+			}
+			return nil, fmt.Errorf("%w: %q in %q", ErrNotMetric, rest, code)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownAtom, code)
+}
+
+func invert(terms []term) []term {
+	inverted := make([]term, len(terms))
+	for i, t := range terms {
+		inverted[i] = term{t.unit, -t.exponent}
+	}
+	return inverted
+}
+
+// combine merges the exponents of repeated units, keeping the order of first appearance and dropping
+// dimensionless factors of one and units whose exponents cancel out.
+func combine(terms []term) []term {
+	var combined []term
+	index := make(map[metric.Unit]int)
+
+	for _, t := range terms {
+		if t.unit == metric.One {
+			continue
+		}
+		if i, ok := index[t.unit]; ok {
+			combined[i].exponent += t.exponent
+			continue
+		}
+		index[t.unit] = len(combined)
+		combined = append(combined, t)
+	}
+
+	result := combined[:0]
+	for _, t := range combined {
+		if t.exponent != 0 {
+			result = append(result, t)
+		}
+	}
+
+	return result
+}
+
+// unitOf returns the Unit with the given terms, as given by metric.UnitOf.
+func unitOf(terms []term) metric.Unit {
+	return metric.UnitOf(derivedTerms(terms)...)
+}
+
+// derivedTerms returns the terms as DerivedUnitTerms.
+func derivedTerms(terms []term) []metric.DerivedUnitTerm {
+	derived := make([]metric.DerivedUnitTerm, len(terms))
+	for i, t := range terms {
+		derived[i] = metric.NewDerivedUnitTerm(t.unit, t.exponent)
+	}
+	return derived
+}
+
+// annotationUnit returns the dimensionless unit of a standalone annotation such as {count}.
+func annotationUnit(annotation string) metric.Unit {
+	code := "{" + annotation + "}"
+	return dynamicUnit(code, func() metric.Unit {
+		return metric.NewDerivedUnit(
+			annotation,
+			fmt.Sprintf("The annotation %s counts something dimensionless", code),
+			code,
+			nil,
+			metric.NewDerivedUnitTerm(metric.One, 1),
+		)
+	})
+}
+
+// annotatedUnit returns the unit with the annotation attached, e.g. mL{total}.
+// It has the terms of the unit but is a distinct unit.
+func annotatedUnit(terms []term, annotation string) metric.Unit {
+	code, _ := encodeTerms(terms)
+	code += "{" + annotation + "}"
+
+	return dynamicUnit(code, func() metric.Unit {
+		symbol := metric.SymbolOf(derivedTerms(terms)...)
+		return metric.NewDerivedUnit(
+			code,
+			fmt.Sprintf("The unit %s annotated with %s", symbol, annotation),
+			symbol+"{"+annotation+"}",
+			nil,
+			derivedTerms(terms)...,
+		)
+	})
+}
+
+// factorUnit returns the dimensionless unit of a numeric factor such as 10*3.
+func factorUnit(code string) metric.Unit {
+	return dynamicUnit(code, func() metric.Unit {
+		return metric.NewDerivedUnit(
+			code,
+			fmt.Sprintf("The numeric factor %s", code),
+			code,
+			nil,
+		)
+	})
+}
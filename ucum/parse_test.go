@@ -0,0 +1,203 @@
+package ucum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/imperial"
+	"github.com/IAmRadek/metric/ucum"
+	isser "github.com/matryer/is"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   metric.Unit
+	}{
+		{expression: "m", expected: metric.Meter},
+		{expression: "kg", expected: metric.Kilogram},
+		{expression: "g", expected: metric.Gram},
+		{expression: "ms", expected: metric.Millisecond},
+		{expression: "Cel", expected: metric.Celsius},
+		{expression: "By/s", expected: metric.BytePerSecond},
+		{expression: "bit/s", expected: metric.BitPerSecond},
+		{expression: "m/s", expected: metric.Speed},
+		{expression: "m2", expected: metric.Area},
+		{expression: "m3", expected: metric.Volume},
+		{expression: "m+2", expected: metric.Area},
+		{expression: "kBy", expected: metric.Kilobyte},
+		{expression: "KiBy", expected: metric.Kibibyte},
+		{expression: "dB", expected: metric.Decibel},
+		{expression: "%", expected: metric.Percent},
+		{expression: "[ppm]", expected: metric.PartsPerMillion},
+		{expression: "deg", expected: metric.Degree},
+		{expression: "''", expected: metric.Arcsecond},
+		{expression: "[pH]", expected: metric.PH},
+		{expression: "1", expected: metric.One},
+		{expression: "m/m", expected: metric.One},
+		{expression: "l", expected: ucum.Liter},
+		{expression: "[iU]", expected: ucum.InternationalUnit},
+		{expression: "[arb'U]", expected: ucum.ArbitraryUnit},
+		{expression: "m.m", expected: metric.Area},
+		{expression: "(m.m.m)", expected: metric.Volume},
+		{expression: "J", expected: metric.Joule},
+		{expression: "kg.m2/s2", expected: metric.Joule},
+		{expression: "s-3.kg.m2", expected: metric.Watt},
+		{expression: "N", expected: metric.Newton},
+		{expression: "kg.m/s2", expected: metric.Newton},
+		{expression: "Hz", expected: metric.Hertz},
+		{expression: "/s", expected: metric.Hertz},
+		{expression: "Ohm", expected: ucum.Ohm},
+		{expression: "C", expected: ucum.Coulomb},
+		{expression: "eq", expected: ucum.Equivalent},
+		{expression: "U", expected: ucum.EnzymeUnit},
+		{expression: "a", expected: ucum.Year},
+		{expression: "mo", expected: ucum.Month},
+		{expression: "[degF]", expected: imperial.DegreeFahrenheit},
+		{expression: "[in_i]", expected: imperial.Inch},
+		{expression: "[lb_av]", expected: imperial.Pound},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expression, func(t *testing.T) {
+			is := isser.New(t)
+
+			unit, err := ucum.Parse(tt.expression)
+			is.NoErr(err)
+			is.Equal(unit, tt.expected)
+		})
+	}
+}
+
+func TestParseDerived(t *testing.T) {
+	tests := []struct {
+		expression string
+		symbol     string
+		terms      int
+	}{
+		{expression: "mg/dL", symbol: "mg/dL", terms: 2},
+		{expression: "kg.m/s3", symbol: "kg*m/s³", terms: 3},
+		{expression: "{count}/min", symbol: "{count}/min", terms: 2},
+		{expression: "/min", symbol: "1/min", terms: 1},
+		{expression: "10*3/uL", symbol: "10*3/µL", terms: 2},
+		{expression: "[IU]/L", symbol: "IU/L", terms: 2},
+		{expression: "mm[Hg].s", symbol: "mmHg*s", terms: 2},
+		{expression: "(m/s)/s", symbol: "m/s²", terms: 2},
+		{expression: "m/(s.s)", symbol: "m/s²", terms: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expression, func(t *testing.T) {
+			is := isser.New(t)
+
+			unit, err := ucum.Parse(tt.expression)
+			is.NoErr(err)
+			is.Equal(unit.Symbol(), tt.symbol)
+
+			derived, ok := unit.(metric.DerivedUnit)
+			is.True(ok)
+			is.Equal(len(derived.Terms()), tt.terms)
+
+			again, err := ucum.Parse(tt.expression)
+			is.NoErr(err)
+			is.Equal(again, unit)
+		})
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	is := isser.New(t)
+
+	count, err := ucum.Parse("{count}")
+	is.NoErr(err)
+	is.Equal(count.Symbol(), "{count}")
+	is.True(metric.IsDimensionless(count))
+
+	total, err := ucum.Parse("mL{total}")
+	is.NoErr(err)
+	is.Equal(total.Symbol(), "mL{total}")
+	is.True(total != ucum.MustParse("mL"))
+}
+
+func TestParseConversions(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity metric.Quantity
+		target   string
+		expected float64
+	}{
+		{name: "MilligramToKilogram", quantity: metric.NewQuantity(2_500, ucum.MustParse("mg")), target: "kg", expected: 0.0025},
+		{name: "MillimeterOfMercuryToPascal", quantity: metric.NewQuantity(1, ucum.MustParse("mm[Hg]")), target: "Pa", expected: 133.322387415},
+		{name: "KilopascalToMillimeterOfMercury", quantity: metric.NewQuantity(13.3322387415, ucum.MustParse("kPa")), target: "mm[Hg]", expected: 100},
+		{name: "DeciliterToCubicMeter", quantity: metric.NewQuantity(10, ucum.MustParse("dL")), target: "m3", expected: 0.001},
+		{name: "MilliliterAlias", quantity: metric.NewQuantity(1, ucum.MustParse("ml")), target: "uL", expected: 1_000},
+		{name: "KilometerToMeter", quantity: metric.NewQuantity(1.5, ucum.MustParse("km")), target: "m", expected: 1_500},
+		{name: "KilojouleToJoule", quantity: metric.NewQuantity(2, ucum.MustParse("kJ")), target: "J", expected: 2_000},
+		{name: "MilligramPerDeciliterToGramPerLiter", quantity: metric.NewQuantity(100, ucum.MustParse("mg/dL")), target: "g/L", expected: 1},
+		{name: "MilliequivalentToEquivalent", quantity: metric.NewQuantity(250, ucum.MustParse("meq")), target: "eq", expected: 0.25},
+		{name: "EnzymeUnitToMilliunit", quantity: metric.NewQuantity(2, ucum.MustParse("U")), target: "mU", expected: 2_000},
+		{name: "KiloohmToOhm", quantity: metric.NewQuantity(4.7, ucum.MustParse("kOhm")), target: "Ohm", expected: 4_700},
+		{name: "MillicoulombToCoulomb", quantity: metric.NewQuantity(500, ucum.MustParse("mC")), target: "C", expected: 0.5},
+		{name: "CentimeterOfWaterToPascal", quantity: metric.NewQuantity(1, ucum.MustParse("cm[H2O]")), target: "Pa", expected: 98.0665},
+		{name: "MeterOfWaterToKilopascal", quantity: metric.NewQuantity(1, ucum.MustParse("m[H2O]")), target: "kPa", expected: 9.80665},
+		{name: "YearToDay", quantity: metric.NewQuantity(1, ucum.MustParse("a")), target: "d", expected: 365.25},
+		{name: "MonthToDay", quantity: metric.NewQuantity(1, ucum.MustParse("mo")), target: "d", expected: 30.4375},
+		{name: "YearToMonth", quantity: metric.NewQuantity(2, ucum.MustParse("a")), target: "mo", expected: 24},
+		{name: "FahrenheitToCelsius", quantity: metric.NewQuantity(212, ucum.MustParse("[degF]")), target: "Cel", expected: 100},
+		{name: "InchToCentimeter", quantity: metric.NewQuantity(1, ucum.MustParse("[in_i]")), target: "cm", expected: 2.54},
+		{name: "PoundToGram", quantity: metric.NewQuantity(1, ucum.MustParse("[lb_av]")), target: "g", expected: 453.59237},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			is := isser.New(t)
+
+			converted, err := metric.UnitConverter.Convert(tt.quantity, ucum.MustParse(tt.target))
+			is.NoErr(err)
+			is.Equal(float32(converted.Amount()), float32(tt.expected))
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   error
+	}{
+		{expression: "", expected: ucum.ErrSyntax},
+		{expression: "m s", expected: ucum.ErrSyntax},
+		{expression: "m/", expected: ucum.ErrSyntax},
+		{expression: "(m/s", expected: ucum.ErrSyntax},
+		{expression: "m)", expected: ucum.ErrSyntax},
+		{expression: "m{total", expected: ucum.ErrSyntax},
+		{expression: "[ppm", expected: ucum.ErrSyntax},
+		{expression: "m0", expected: ucum.ErrSyntax},
+		{expression: "10*", expected: ucum.ErrSyntax},
+		{expression: "M", expected: ucum.ErrUnknownAtom},
+		{expression: "meter", expected: ucum.ErrUnknownAtom},
+		{expression: "KG", expected: ucum.ErrUnknownAtom},
+		{expression: "m*s", expected: ucum.ErrUnknownAtom},
+		{expression: "kmin", expected: ucum.ErrNotMetric},
+		{expression: "mCel", expected: ucum.ErrNotMetric},
+		{expression: "k[arb'U]", expected: ucum.ErrNotMetric},
+		{expression: "k[degF]", expected: ucum.ErrNotMetric},
+		{expression: "[gal_us]", expected: ucum.ErrUnsupportedAtom},
+		{expression: "kat", expected: ucum.ErrUnsupportedAtom},
+		{expression: "ukat", expected: ucum.ErrUnsupportedAtom},
+		{expression: "k[gal_us]", expected: ucum.ErrNotMetric},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.expression, func(t *testing.T) {
+			is := isser.New(t)
+
+			err := ucum.Validate(tt.expression)
+			is.True(errors.Is(err, tt.expected))
+		})
+	}
+}
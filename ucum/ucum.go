@@ -0,0 +1,393 @@
+// Package ucum parses and encodes units in the case-sensitive form of the Unified Code for Units of Measure,
+// e.g. "mg/dL", "mm[Hg]", "{count}/min" or "By/s".
+//
+// Atoms are mapped onto the units of the metric package wherever they exist, and the customary units onto
+// the imperial package. Atoms of the UCUM essence table without an equivalent there, such as the liter or
+// millimeter of mercury, are defined in UCUMSystemOfUnits.
+//
+// Only a subset of the essence table is supported: the SI units of the metric package, the liter, ohm, coulomb,
+// equivalent, enzyme unit, meter of mercury and of water, the mean Julian year and month, the international and
+// arbitrary units, and the inch, foot, yard, mile, pound, ounce and degree Fahrenheit. The remaining atoms of the
+// table are rejected with ErrUnsupportedAtom.
+package ucum
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/IAmRadek/metric"
+	"github.com/IAmRadek/metric/imperial"
+)
+
+var (
+	ErrSyntax          = errors.New("invalid UCUM expression")
+	ErrUnknownAtom     = errors.New("unknown UCUM unit")
+	ErrUnsupportedAtom = errors.New("unsupported UCUM unit")
+	ErrNotMetric       = errors.New("UCUM unit does not accept prefixes")
+	ErrNotEncodable    = errors.New("unit has no UCUM code")
+)
+
+var (
+	UCUMSystemOfUnits = metric.NewSystemOfUnits("UCUM", "Regenstrief Institute")
+
+	Liter = metric.NewBaseUnit(
+		"liter",
+		"The liter is a unit of volume equal to one cubic decimeter",
+		"L",
+		UCUMSystemOfUnits,
+	)
+	Ohm = metric.NewDerivedUnit(
+		"ohm",
+		"The ohm is the electrical resistance through which one ampere flows under one volt",
+		"Ω",
+		UCUMSystemOfUnits,
+		metric.NewDerivedUnitTerm(metric.Volt, 1),
+		metric.NewDerivedUnitTerm(metric.Ampere, -1),
+	)
+	Coulomb = metric.NewDerivedUnit(
+		"coulomb",
+		"The coulomb is the electric charge carried by a current of one ampere in one second",
+		"C",
+		UCUMSystemOfUnits,
+		metric.NewDerivedUnitTerm(metric.Ampere, 1),
+		metric.NewDerivedUnitTerm(metric.Second, 1),
+	)
+	Equivalent = metric.NewBaseUnit(
+		"equivalent",
+		"The equivalent is the amount of a substance that reacts with one mole of hydrogen ions, depending on its valence",
+		"eq",
+		UCUMSystemOfUnits,
+	)
+	EnzymeUnit = metric.NewBaseUnit(
+		"enzyme unit",
+		"The enzyme unit is the catalytic activity that converts one micromole of substrate per minute",
+		"U",
+		UCUMSystemOfUnits,
+	)
+	MeterOfMercury = metric.NewBaseUnit(
+		"meter of mercury column",
+		"The meter of mercury column is the pressure exerted by a one meter high column of mercury, equal to 133.322387415 kPa",
+		"mHg",
+		UCUMSystemOfUnits,
+	)
+	MeterOfWater = metric.NewBaseUnit(
+		"meter of water column",
+		"The meter of water column is the pressure exerted by a one meter high column of water, equal to 9.80665 kPa",
+		"mH2O",
+		UCUMSystemOfUnits,
+	)
+	Year = metric.NewBaseUnit(
+		"year",
+		"The mean Julian year is a unit of time equal to 365.25 days",
+		"a",
+		UCUMSystemOfUnits,
+	)
+	Month = metric.NewBaseUnit(
+		"month",
+		"The mean Julian month is a unit of time equal to one twelfth of a mean Julian year",
+		"mo",
+		UCUMSystemOfUnits,
+	)
+	InternationalUnit = metric.NewBaseUnit(
+		"international unit",
+		"The international unit is an arbitrary unit of biological activity defined separately for each substance",
+		"IU",
+		UCUMSystemOfUnits,
+	)
+	ArbitraryUnit = metric.NewBaseUnit(
+		"arbitrary unit",
+		"The arbitrary unit is a unit of a procedure-defined quantity that cannot be converted to any other unit",
+		"arb. U",
+		UCUMSystemOfUnits,
+	)
+)
+
+// prefix is a UCUM decimal prefix.
+type prefix struct {
+	code   string
+	prefix metric.SIPrefix
+}
+
+// prefixes lists the UCUM decimal prefixes in descending order.
+var prefixes = []prefix{
+	{"Y", metric.Yotta}, {"Z", metric.Zetta}, {"E", metric.Exa}, {"P", metric.Peta}, {"T", metric.Tera},
+	{"G", metric.Giga}, {"M", metric.Mega}, {"k", metric.Kilo}, {"h", metric.Hecto}, {"da", metric.Deca},
+	{"d", metric.Deci}, {"c", metric.Centi}, {"m", metric.Milli}, {"u", metric.Micro}, {"n", metric.Nano},
+	{"p", metric.Pico}, {"f", metric.Femto}, {"a", metric.Atto}, {"z", metric.Zepto}, {"y", metric.Yocto},
+}
+
+// atom describes an entry of the UCUM essence table.
+type atom struct {
+	code string
+	unit metric.Unit
+	// metric is true if the atom accepts prefixes.
+	metric bool
+}
+
+// atoms lists the supported UCUM unit atoms by their case-sensitive code.
+// The order is that of registration: the first code of a unit is its canonical code.
+var atoms = []atom{
+	{"m", metric.Meter, true},
+	{"s", metric.Second, true},
+	{"g", metric.Gram, true},
+	{"rad", metric.Radian, true},
+	{"sr", metric.Steradian, true},
+	{"K", metric.Kelvin, true},
+	{"A", metric.Ampere, true},
+	{"mol", metric.Mole, true},
+	{"cd", metric.Candela, true},
+	{"W", metric.Watt, true},
+	{"V", metric.Volt, true},
+	{"Ohm", Ohm, true},
+	{"C", Coulomb, true},
+	{"lm", metric.Lumen, true},
+	{"lx", metric.Lux, true},
+	{"L", Liter, true},
+	{"Pa", metric.Pascal, true},
+	{"m[Hg]", MeterOfMercury, true},
+	{"m[H2O]", MeterOfWater, true},
+	{"eq", Equivalent, true},
+	{"U", EnzymeUnit, true},
+	{"Hz", metric.Hertz, true},
+	{"N", metric.Newton, true},
+	{"J", metric.Joule, true},
+	{"[IU]", InternationalUnit, true},
+	{"[arb'U]", ArbitraryUnit, false},
+	{"Cel", metric.Celsius, false},
+	{"min", metric.Minute, false},
+	{"h", metric.Hour, false},
+	{"d", metric.Day, false},
+	{"wk", metric.Week, false},
+	{"mo", Month, false},
+	{"a", Year, false},
+	{"bit", metric.Bit, false},
+	{"By", metric.Byte, false},
+	{"B", metric.Bel, false},
+	{"Np", metric.Neper, false},
+	{"%", metric.Percent, false},
+	{"[ppth]", metric.Permille, false},
+	{"[ppm]", metric.PartsPerMillion, false},
+	{"[ppb]", metric.PartsPerBillion, false},
+	{"[pH]", metric.PH, false},
+	{"deg", metric.Degree, false},
+	{"'", metric.Arcminute, false},
+	{"''", metric.Arcsecond, false},
+	{"gon", metric.Gradian, false},
+	{"circ", metric.Turn, false},
+	{"[in_i]", imperial.Inch, false},
+	{"[ft_i]", imperial.Foot, false},
+	{"[yd_i]", imperial.Yard, false},
+	{"[mi_i]", imperial.Mile, false},
+	{"[lb_av]", imperial.Pound, false},
+	{"[oz_av]", imperial.Ounce, false},
+	{"[degF]", imperial.DegreeFahrenheit, false},
+	{"1", metric.One, false},
+}
+
+// alias is an alternative code of an atom, accepted when parsing but never produced when encoding.
+type alias struct {
+	code string
+	atom string
+}
+
+var aliases = []alias{
+	{"l", "L"},
+	{"[iU]", "[IU]"},
+}
+
+// prefixedAtom is a prefixed atom that maps onto a dedicated unit of the metric package.
+type prefixedAtom struct {
+	code string
+	unit metric.Unit
+}
+
+var prefixedAtoms = []prefixedAtom{
+	{"kBy", metric.Kilobyte},
+	{"MBy", metric.Megabyte},
+	{"GBy", metric.Gigabyte},
+	{"TBy", metric.Terabyte},
+	{"KiBy", metric.Kibibyte},
+	{"MiBy", metric.Mebibyte},
+	{"GiBy", metric.Gibibyte},
+	{"TiBy", metric.Tebibyte},
+	{"dB", metric.Decibel},
+}
+
+var (
+	// units maps every valid simple unit code, prefixed or not, to its Unit. It is read-only after init.
+	units = make(map[string]metric.Unit)
+	// codes maps units back to their canonical code. It is read-only after init.
+	codes = make(map[metric.Unit]string)
+	// unsupported maps the codes of unsupportedMetricAtoms and unsupportedAtoms to whether they accept prefixes.
+	// It is read-only after init.
+	unsupported = make(map[string]bool)
+
+	// The codes of units created while parsing, such as annotations and products of units.
+	mu           sync.RWMutex
+	dynamicUnits = make(map[string]metric.Unit)
+	dynamicCodes = make(map[metric.Unit]string)
+)
+
+// ratio describes a unit as numerator/denominator units of a reference unit shared by its family.
+type ratio struct {
+	unit        metric.Unit
+	numerator   float64
+	denominator float64
+}
+
+func init() {
+	for _, atom := range atoms {
+		register(atom.code, atom.unit)
+	}
+	for _, atom := range prefixedAtoms {
+		register(atom.code, atom.unit)
+	}
+
+	// Units of the metric package already have their prefixed forms.
+	for _, atom := range atoms {
+		if !atom.metric || !metric.SupportsPrefixes(atom.unit) {
+			continue
+		}
+		for _, p := range prefixes {
+			unit, err := metric.Prefixed(p.prefix, atom.unit)
+			if err != nil {
+				panic(fmt.Sprintf("ucum: prefixing %s: %v", atom.code, err))
+			}
+			register(p.code+atom.code, unit)
+		}
+	}
+
+	registerConversions(prefixFamily("L", Liter, 1), ratio{metric.Volume, 1_000, 1})
+
+	// The prefixed forms of the pascal convert through the pascal.
+	pressures := prefixFamily("m[Hg]", MeterOfMercury, 133_322.387415)
+	pressures = append(pressures, prefixFamily("m[H2O]", MeterOfWater, 9_806.65)...)
+	registerConversions(pressures, ratio{metric.Pascal, 1, 1})
+
+	registerConversions(prefixFamily("Ohm", Ohm, 1))
+	registerConversions(prefixFamily("C", Coulomb, 1))
+	registerConversions(prefixFamily("eq", Equivalent, 1))
+	registerConversions(prefixFamily("U", EnzymeUnit, 1))
+	registerConversions(prefixFamily("[IU]", InternationalUnit, 1))
+
+	registerConversions(
+		[]ratio{{Year, 31_557_600, 1}, {Month, 2_629_800, 1}},
+		ratio{metric.Second, 1, 1},
+		ratio{metric.Minute, 60, 1},
+		ratio{metric.Hour, 3_600, 1},
+		ratio{metric.Day, 86_400, 1},
+		ratio{metric.Week, 604_800, 1},
+	)
+
+	for _, code := range unsupportedMetricAtoms {
+		unsupported[code] = true
+	}
+	for _, code := range unsupportedAtoms {
+		unsupported[code] = false
+	}
+
+	// Aliases come last so that codes keeps the canonical code.
+	for _, alias := range aliases {
+		register(alias.code, units[alias.atom])
+		for _, p := range prefixes {
+			register(p.code+alias.code, units[p.code+alias.atom])
+		}
+	}
+}
+
+// register adds the code of a unit, keeping the first code registered for the unit as canonical.
+func register(code string, unit metric.Unit) {
+	units[code] = unit
+	if _, ok := codes[unit]; !ok {
+		codes[unit] = code
+	}
+}
+
+// prefixFamily creates and registers the prefixed forms of an atom defined in this package.
+// The factor is the size of the atom relative to the first unit of the family.
+func prefixFamily(code string, base metric.Unit, factor float64) []ratio {
+	family := []ratio{{base, factor, 1}}
+
+	for _, p := range prefixes {
+		prefix := p.prefix
+		unit := metric.NewDerivedUnit(
+			prefix.Name+base.Name(),
+			fmt.Sprintf("The %s%s is equal to 10^%d %s", prefix.Name, base.Name(), prefix.Exponent, base.Name()),
+			prefix.Symbol+base.Symbol(),
+			nil,
+			metric.NewDerivedUnitTerm(base, 1),
+		)
+		register(p.code+code, unit)
+
+		scale := math.Pow(10, math.Abs(float64(prefix.Exponent)))
+		if prefix.Exponent < 0 {
+			family = append(family, ratio{unit, factor, scale})
+		} else {
+			family = append(family, ratio{unit, factor * scale, 1})
+		}
+	}
+
+	return family
+}
+
+// registerConversions registers conversions between every pair of units of the family, and between the family
+// and the existing units, leaving the conversions among the existing units untouched.
+func registerConversions(family []ratio, existing ...ratio) {
+	for _, source := range family {
+		for _, target := range family {
+			if source.unit != target.unit {
+				registerConversion(source, target)
+			}
+		}
+		for _, other := range existing {
+			registerConversion(source, other)
+			registerConversion(other, source)
+		}
+	}
+}
+
+// registerConversion registers the conversion from source to target.
+func registerConversion(source, target ratio) {
+	metric.NewStandardConversion(source.unit, target.unit, func(quantity metric.Quantity) (metric.Quantity, error) {
+		amount := quantity.Amount() * source.numerator * target.denominator / (source.denominator * target.numerator)
+		return metric.NewQuantity(amount, target.unit), nil
+	})
+}
+
+// lookupCode returns the canonical code of the unit, if it has one.
+func lookupCode(unit metric.Metric) (string, bool) {
+	u, ok := unit.(metric.Unit)
+	if !ok {
+		return "", false
+	}
+
+	if code, ok := codes[u]; ok {
+		return code, true
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+
+	code, ok := dynamicCodes[u]
+	return code, ok
+}
+
+// dynamicUnit returns the unit created for the code, creating it with create on first use.
+func dynamicUnit(code string, create func() metric.Unit) metric.Unit {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if unit, ok := dynamicUnits[code]; ok {
+		return unit
+	}
+
+	unit := create()
+	dynamicUnits[code] = unit
+	if _, ok := dynamicCodes[unit]; !ok {
+		dynamicCodes[unit] = code
+	}
+
+	return unit
+}